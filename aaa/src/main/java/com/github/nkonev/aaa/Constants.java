@@ -24,6 +24,7 @@ public class Constants {
         public static final String LIST = "/list";
 
         public static final String SEARCH = "/search";
+        public static final String BY_LOGIN = "/by-login";
 
         public static final String LOCK = "/lock";
         public static final String USER_ID = "/{"+PathVariables.USER_ID+"}";
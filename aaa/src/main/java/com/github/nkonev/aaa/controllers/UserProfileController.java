@@ -197,6 +197,23 @@ public class UserProfileController {
         return getUsers(userIds, userAccountPrincipal);
     }
 
+    // chat resolves the mentioned logins without fetching all the participants
+    @GetMapping(value = Constants.Urls.INTERNAL_API+Constants.Urls.USER+Constants.Urls.BY_LOGIN)
+    public List<Record> getUsersByLoginsInternal(
+            @RequestParam(value = "login") List<String> logins
+    ) {
+        LOGGER.info("Requesting internal users by logins {}", logins);
+        if (logins.size() > MAX_USERS_RESPONSE_LENGTH) {
+            throw new BadRequestException("Cannot be greater than " + MAX_USERS_RESPONSE_LENGTH);
+        }
+        List<String> lowercasedLogins = logins.stream().map(String::toLowerCase).collect(Collectors.toList());
+        List<Record> result = new ArrayList<>();
+        for (UserAccount userAccountEntity: userAccountRepository.findByLowercasedUsernameIn(lowercasedLogins)) {
+            result.add(userAccountConverter.convertToUserAccountDTO(userAccountEntity));
+        }
+        return result;
+    }
+
     @PostMapping(Constants.Urls.API+Constants.Urls.PROFILE)
     @PreAuthorize("isAuthenticated()")
     public com.github.nkonev.aaa.dto.EditUserDTO editProfile(
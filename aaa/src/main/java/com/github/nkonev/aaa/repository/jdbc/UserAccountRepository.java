@@ -41,4 +41,7 @@ public interface UserAccountRepository extends CrudRepository<UserAccount, Long>
     void updateLastLogin(@Param("userName") String username, @Param("newLastLoginDateTime") LocalDateTime localDateTime);
 
     List<UserAccount> findByIdInOrderById(List<Long> userIds);
+
+    @Query("select * from users u where lower(u.username) in (:userNames) order by id")
+    List<UserAccount> findByLowercasedUsernameIn(@Param("userNames") List<String> lowercasedUsernames);
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/tls"
 	"encoding/json"
+	"fmt"
 	"github.com/spf13/viper"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.opentelemetry.io/otel"
@@ -95,6 +96,63 @@ func (rc RestClient) GetUsers(userIds []int64, c context.Context) ([]*dto.User,
 	return *users, nil
 }
 
+// GetUsersByLogins returns the users with the given logins, the letter case doesn't matter
+func (rc RestClient) GetUsersByLogins(logins []string, c context.Context) ([]*dto.User, error) {
+	contentType := "application/json;charset=UTF-8"
+	url0 := viper.GetString("aaa.url.base")
+	url1 := viper.GetString("aaa.url.getUsersByLogins")
+	fullUrl := url0 + url1
+
+	query := url.Values{}
+	for _, login := range logins {
+		query.Add("login", login)
+	}
+
+	requestHeaders := map[string][]string{
+		"Accept-Encoding": {"gzip, deflate"},
+		"Accept":          {contentType},
+		"Content-Type":    {contentType},
+	}
+
+	parsedUrl, err := url.Parse(fullUrl + "?" + query.Encode())
+	if err != nil {
+		GetLogEntry(c).Errorln("Failed during parse aaa url:", err)
+		return nil, err
+	}
+	request := &http.Request{
+		Method: "GET",
+		Header: requestHeaders,
+		URL:    parsedUrl,
+	}
+
+	ctx, span := rc.tracer.Start(c, "users.GetByLogins")
+	defer span.End()
+	request = request.WithContext(ctx)
+	resp, err := rc.Do(request)
+	if err != nil {
+		GetLogEntry(c).Warningln("Failed to request get users by logins response:", err)
+		return nil, err
+	}
+	defer resp.Body.Close()
+	code := resp.StatusCode
+	if code != 200 {
+		GetLogEntry(c).Warningln("Users by logins response responded non-200 code: ", code)
+		return nil, fmt.Errorf("unexpected status %v", code)
+	}
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		GetLogEntry(c).Errorln("Failed to decode get users by logins response:", err)
+		return nil, err
+	}
+
+	users := &[]*dto.User{}
+	if err := json.Unmarshal(bodyBytes, users); err != nil {
+		GetLogEntry(c).Errorln("Failed to parse users:", err)
+		return nil, err
+	}
+	return *users, nil
+}
+
 func (rc RestClient) SearchGetUsers(searchString string, excludingIds []int64, c context.Context) ([]*dto.User, error) {
 	contentType := "application/json;charset=UTF-8"
 	url0 := viper.GetString("aaa.url.base")
@@ -114,7 +172,7 @@ func (rc RestClient) SearchGetUsers(searchString string, excludingIds []int64, c
 		"Content-Type":    {contentType},
 	}
 
-	parsedUrl, err := url.Parse(fullUrl + "?excludingUserId=" + excludingUserIdsJoinedToString + "&searchString=" + searchString)
+	parsedUrl, err := url.Parse(fullUrl + "?excludingUserId=" + excludingUserIdsJoinedToString + "&searchString=" + url.QueryEscape(searchString))
 	if err != nil {
 		GetLogEntry(c).Errorln("Failed during parse aaa url:", err)
 		return nil, err
@@ -152,3 +210,36 @@ func (rc RestClient) SearchGetUsers(searchString string, excludingIds []int64, c
 	}
 	return *users, nil
 }
+
+// Dial starts the call in the chat on behalf of the user and dials the rest participants
+func (rc RestClient) Dial(chatId, behalfUserId int64, c context.Context) error {
+	url0 := viper.GetString("video.url.base")
+	url1 := fmt.Sprintf(viper.GetString("video.url.dial"), chatId)
+	fullUrl := url0 + url1
+
+	parsedUrl, err := url.Parse(fullUrl + "?behalfUserId=" + utils.Int64ToString(behalfUserId))
+	if err != nil {
+		GetLogEntry(c).Errorln("Failed during parse video url:", err)
+		return err
+	}
+	request := &http.Request{
+		Method: "PUT",
+		URL:    parsedUrl,
+	}
+
+	ctx, span := rc.tracer.Start(c, "video.Dial")
+	defer span.End()
+	request = request.WithContext(ctx)
+	resp, err := rc.Do(request)
+	if err != nil {
+		GetLogEntry(c).Warningln("Failed to request dial response:", err)
+		return err
+	}
+	defer resp.Body.Close()
+	code := resp.StatusCode
+	if code != http.StatusOK && code != http.StatusAccepted {
+		GetLogEntry(c).Warningln("Dial response responded non-200 code: ", code)
+		return fmt.Errorf("unexpected status %v", code)
+	}
+	return nil
+}
@@ -11,6 +11,19 @@ import (
 	"time"
 )
 
+const (
+	ChatVisibilityPrivate = "private"
+	ChatVisibilityPublic  = "public"
+	ChatVisibilityLink    = "link"
+)
+
+// the types of chat to filter the list by
+const (
+	ChatTypeTetATet = "tetATet"
+	ChatTypeChannel = "channel"
+	ChatTypeGroup   = "group" // neither tet-a-tet nor channel
+)
+
 // db model
 type Chat struct {
 	Id                 int64
@@ -19,13 +32,16 @@ type Chat struct {
 	TetATet            bool
 	Avatar             null.String
 	AvatarBig          null.String
+	Pinned             bool // is personal for the participant the chat is got for
+	Visibility         string
+	Channel            bool
 }
 
 type ChatWithParticipants struct {
 	Chat
 	ParticipantsIds   []int64
 	ParticipantsCount int
-	IsAdmin           bool
+	Role              string // of the participant the chat is got for
 }
 
 // CreateChat creates a new chat.
@@ -57,7 +73,7 @@ func (tx *Tx) CreateTetATetChat(behalfUserId int64, toParticipantId int64) (int6
 }
 
 func (tx *Tx) IsExistsTetATet(participant1 int64, participant2 int64) (bool, int64, error) {
-	res := tx.QueryRow("select b.chat_id from (select a.count >= 2 as exists, a.chat_id from ( (select cp.chat_id, count(cp.user_id) from chat_participant cp join chat ch on ch.id = cp.chat_id where ch.tet_a_tet = true and (cp.user_id = $1 or cp.user_id = $2) group by cp.chat_id)) a) b where b.exists is true;", participant1, participant2)
+	res := tx.QueryRow("select b.chat_id from (select a.count >= 2 as exists, a.chat_id from ( (select cp.chat_id, count(cp.user_id) from chat_participant cp join chat ch on ch.id = cp.chat_id where ch.tet_a_tet = true and ch.delete_date_time is null and (cp.user_id = $1 or cp.user_id = $2) group by cp.chat_id)) a) b where b.exists is true;", participant1, participant2)
 	var chatId int64
 	if err := res.Scan(&chatId); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -70,10 +86,54 @@ func (tx *Tx) IsExistsTetATet(participant1 int64, participant2 int64) (bool, int
 	return true, chatId, nil
 }
 
-func (db *DB) GetChatsByLimitOffset(participantId int64, limit int, offset int) ([]*Chat, error) {
+// chatsAfterClause continues the list after the given chat, it has the same order as ORDER BY, so the concurrently bumped chats don't shift the next page
+func chatsAfterClause(after *Chat, args []interface{}) (string, []interface{}) {
+	if after == nil {
+		return "", args
+	}
+	clause := fmt.Sprintf("AND (last_update_date_time, id) < ($%v, $%v)", len(args)+1, len(args)+2)
+	return clause, append(args, after.LastUpdateDateTime, after.Id)
+}
+
+// pinnedChatsAfterClause is chatsAfterClause for the list where the pinned chats go first
+func pinnedChatsAfterClause(after *Chat, args []interface{}) (string, []interface{}) {
+	if after == nil {
+		return "", args
+	}
+	clause := fmt.Sprintf("AND (cp.pinned, c.last_update_date_time, c.id) < ($%v, $%v, $%v)", len(args)+1, len(args)+2, len(args)+3)
+	return clause, append(args, after.Pinned, after.LastUpdateDateTime, after.Id)
+}
+
+// chatsArchivedClause narrows the user's chats to the archived or not archived ones, if the filter is set
+func chatsArchivedClause(archived null.Bool, args []interface{}) (string, []interface{}) {
+	if !archived.Valid {
+		return "", args
+	}
+	clause := fmt.Sprintf("AND cp.archived = $%v", len(args)+1)
+	return clause, append(args, archived.Bool)
+}
+
+// chatsTypeClause narrows the user's chats to the given type, if the filter is set
+func chatsTypeClause(chatType string) string {
+	switch chatType {
+	case ChatTypeTetATet:
+		return "AND c.tet_a_tet = true"
+	case ChatTypeChannel:
+		return "AND c.channel = true"
+	case ChatTypeGroup:
+		return "AND c.tet_a_tet = false AND c.channel = false"
+	default:
+		return ""
+	}
+}
+
+// GetChatsByLimitOffset returns the page of chats either by offset or, if after is set, by keyset. The pinned chats go first
+func (db *DB) GetChatsByLimitOffset(participantId int64, limit int, offset int, after *Chat, archived null.Bool, chatType string) ([]*Chat, error) {
 	var rows *sql.Rows
 	var err error
-	rows, err = db.Query(`SELECT id, title, avatar, avatar_big, last_update_date_time, tet_a_tet FROM chat WHERE id IN ( SELECT chat_id FROM chat_participant WHERE user_id = $1 ) ORDER BY (last_update_date_time, id) DESC LIMIT $2 OFFSET $3`, participantId, limit, offset)
+	archivedClause, args := chatsArchivedClause(archived, []interface{}{participantId, limit, offset})
+	afterClause, args := pinnedChatsAfterClause(after, args)
+	rows, err = db.Query(fmt.Sprintf(`SELECT c.id, c.title, c.avatar, c.avatar_big, c.last_update_date_time, c.tet_a_tet, cp.pinned, c.visibility, c.channel FROM chat c JOIN chat_participant cp ON cp.chat_id = c.id AND cp.user_id = $1 WHERE c.delete_date_time IS NULL %s %s %s ORDER BY (cp.pinned, c.last_update_date_time, c.id) DESC LIMIT $2 OFFSET $3`, archivedClause, chatsTypeClause(chatType), afterClause), args...)
 	if err != nil {
 		Logger.Errorf("Error during get chat rows %v", err)
 		return nil, err
@@ -82,7 +142,7 @@ func (db *DB) GetChatsByLimitOffset(participantId int64, limit int, offset int)
 		list := make([]*Chat, 0)
 		for rows.Next() {
 			chat := Chat{}
-			if err := rows.Scan(&chat.Id, &chat.Title, &chat.Avatar, &chat.AvatarBig, &chat.LastUpdateDateTime, &chat.TetATet); err != nil {
+			if err := rows.Scan(&chat.Id, &chat.Title, &chat.Avatar, &chat.AvatarBig, &chat.LastUpdateDateTime, &chat.TetATet, &chat.Pinned, &chat.Visibility, &chat.Channel); err != nil {
 				Logger.Errorf("Error during scan chat rows %v", err)
 				return nil, err
 			} else {
@@ -93,11 +153,13 @@ func (db *DB) GetChatsByLimitOffset(participantId int64, limit int, offset int)
 	}
 }
 
-func (db *DB) GetChatsByLimitOffsetSearch(participantId int64, limit int, offset int, searchString string) ([]*Chat, error) {
+func (db *DB) GetChatsByLimitOffsetSearch(participantId int64, limit int, offset int, searchString string, after *Chat, archived null.Bool, chatType string) ([]*Chat, error) {
 	var rows *sql.Rows
 	var err error
 	searchString = "%" + searchString + "%"
-	rows, err = db.Query(`SELECT id, title, avatar, avatar_big, last_update_date_time, tet_a_tet FROM chat WHERE id IN ( SELECT chat_id FROM chat_participant WHERE user_id = $1 ) AND title ILIKE $4 ORDER BY (last_update_date_time, id) DESC LIMIT $2 OFFSET $3`, participantId, limit, offset, searchString)
+	archivedClause, args := chatsArchivedClause(archived, []interface{}{participantId, limit, offset, searchString})
+	afterClause, args := pinnedChatsAfterClause(after, args)
+	rows, err = db.Query(fmt.Sprintf(`SELECT c.id, c.title, c.avatar, c.avatar_big, c.last_update_date_time, c.tet_a_tet, cp.pinned, c.visibility, c.channel FROM chat c JOIN chat_participant cp ON cp.chat_id = c.id AND cp.user_id = $1 WHERE c.delete_date_time IS NULL AND c.title ILIKE $4 %s %s %s ORDER BY (cp.pinned, c.last_update_date_time, c.id) DESC LIMIT $2 OFFSET $3`, archivedClause, chatsTypeClause(chatType), afterClause), args...)
 	if err != nil {
 		Logger.Errorf("Error during get chat rows %v", err)
 		return nil, err
@@ -106,7 +168,7 @@ func (db *DB) GetChatsByLimitOffsetSearch(participantId int64, limit int, offset
 		list := make([]*Chat, 0)
 		for rows.Next() {
 			chat := Chat{}
-			if err := rows.Scan(&chat.Id, &chat.Title, &chat.Avatar, &chat.AvatarBig, &chat.LastUpdateDateTime, &chat.TetATet); err != nil {
+			if err := rows.Scan(&chat.Id, &chat.Title, &chat.Avatar, &chat.AvatarBig, &chat.LastUpdateDateTime, &chat.TetATet, &chat.Pinned, &chat.Visibility, &chat.Channel); err != nil {
 				Logger.Errorf("Error during scan chat rows %v", err)
 				return nil, err
 			} else {
@@ -121,7 +183,7 @@ func convertToWithParticipants(db CommonOperations, chat *Chat, behalfUserId int
 	if ids, err := db.GetParticipantIds(chat.Id, participantsSize, participantsOffset); err != nil {
 		return nil, err
 	} else {
-		admin, err := db.IsAdmin(behalfUserId, chat.Id)
+		role, err := db.GetParticipantRole(behalfUserId, chat.Id)
 		if err != nil {
 			return nil, err
 		}
@@ -132,13 +194,44 @@ func convertToWithParticipants(db CommonOperations, chat *Chat, behalfUserId int
 		ccc := &ChatWithParticipants{
 			Chat:              *chat,
 			ParticipantsIds:   ids,
-			IsAdmin:           admin,
+			Role:              role,
 			ParticipantsCount: participantsCount,
 		}
 		return ccc, nil
 	}
 }
 
+// convertToWithParticipantsBatch does the same as convertToWithParticipants for the page of chats, but with the fixed amount of queries
+func convertToWithParticipantsBatch(db CommonOperations, chats []*Chat, behalfUserId int64, participantsSize, participantsOffset int) ([]*ChatWithParticipants, error) {
+	chatIds := make([]int64, 0, len(chats))
+	for _, cc := range chats {
+		chatIds = append(chatIds, cc.Id)
+	}
+	participantIds, err := getParticipantIdsBatchCommon(db, chatIds, participantsSize, participantsOffset)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := getParticipantRolesBatchCommon(db, behalfUserId, chatIds)
+	if err != nil {
+		return nil, err
+	}
+	participantsCounts, err := getParticipantsCountBatchCommon(db, chatIds)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*ChatWithParticipants, 0)
+	for _, cc := range chats {
+		list = append(list, &ChatWithParticipants{
+			Chat:              *cc,
+			ParticipantsIds:   participantIds[cc.Id],
+			Role:              roles[cc.Id],
+			ParticipantsCount: participantsCounts[cc.Id],
+		})
+	}
+	return list, nil
+}
+
 type ChatQueryByLimitOffset struct {
 	Limit  int
 	Offset int
@@ -148,30 +241,21 @@ type ChatQueryByIds struct {
 	Ids []int64
 }
 
-func (db *DB) GetChatsWithParticipants(participantId int64, limit, offset int, searchString string, userPrincipalDto *auth.AuthResult, participantsSize, participantsOffset int) ([]*ChatWithParticipants, error) {
+func (db *DB) GetChatsWithParticipants(participantId int64, limit, offset int, after *Chat, archived null.Bool, chatType string, searchString string, userPrincipalDto *auth.AuthResult, participantsSize, participantsOffset int) ([]*ChatWithParticipants, error) {
 	var err error
 	var chats []*Chat
 
 	if searchString == "" {
-		chats, err = db.GetChatsByLimitOffset(participantId, limit, offset)
+		chats, err = db.GetChatsByLimitOffset(participantId, limit, offset, after, archived, chatType)
 	} else {
-		chats, err = db.GetChatsByLimitOffsetSearch(participantId, limit, offset, searchString)
+		chats, err = db.GetChatsByLimitOffsetSearch(participantId, limit, offset, searchString, after, archived, chatType)
 	}
 
 	if err != nil {
 		return nil, err
 	} else {
 		fixedParticipantsSize := utils.FixSize(participantsSize)
-		list := make([]*ChatWithParticipants, 0)
-		for _, cc := range chats {
-
-			if ccc, err := convertToWithParticipants(db, cc, userPrincipalDto.UserId, fixedParticipantsSize, participantsOffset); err != nil {
-				return nil, err
-			} else {
-				list = append(list, ccc)
-			}
-		}
-		return list, nil
+		return convertToWithParticipantsBatch(db, chats, userPrincipalDto.UserId, fixedParticipantsSize, participantsOffset)
 	}
 }
 
@@ -195,7 +279,7 @@ func getChatWithParticipantsCommon(commonOps CommonOperations, behalfParticipant
 
 func (db *DB) CountChats() (int64, error) {
 	var count int64
-	row := db.QueryRow("SELECT count(*) FROM chat")
+	row := db.QueryRow("SELECT count(*) FROM chat WHERE delete_date_time IS NULL")
 	err := row.Scan(&count)
 	if err != nil {
 		return 0, err
@@ -206,7 +290,7 @@ func (db *DB) CountChats() (int64, error) {
 
 func (db *DB) CountChatsPerUser(userId int64) (int64, error) {
 	var count int64
-	row := db.QueryRow("SELECT count(*) FROM chat_participant WHERE user_id = $1", userId)
+	row := db.QueryRow("SELECT count(*) FROM chat_participant cp JOIN chat c ON c.id = cp.chat_id WHERE cp.user_id = $1 AND c.delete_date_time IS NULL", userId)
 	err := row.Scan(&count)
 	if err != nil {
 		return 0, err
@@ -215,6 +299,60 @@ func (db *DB) CountChatsPerUser(userId int64) (int64, error) {
 	}
 }
 
+// SoftDeleteChat hides the chat, its messages and participants are kept until PurgeChat
+func (tx *Tx) SoftDeleteChat(id int64) error {
+	if res, err := tx.Exec("UPDATE chat SET delete_date_time = utc_now() WHERE id = $1 AND delete_date_time IS NULL", id); err != nil {
+		Logger.Errorf("Error during soft delete chat %v %v", id, err)
+		return err
+	} else {
+		affected, err := res.RowsAffected()
+		if err != nil {
+			Logger.Errorf("Error during checking rows affected %v", err)
+			return err
+		}
+		if affected == 0 {
+			return errors.New("No rows affected")
+		}
+		return nil
+	}
+}
+
+// RestoreChat brings back the chat deleted less than the retention ago, returns false if there is no such chat
+func (tx *Tx) RestoreChat(id int64, retention time.Duration) (bool, error) {
+	if res, err := tx.Exec("UPDATE chat SET delete_date_time = NULL WHERE id = $1 AND delete_date_time > utc_now() - make_interval(secs => $2)", id, retention.Seconds()); err != nil {
+		Logger.Errorf("Error during restore chat %v %v", id, err)
+		return false, err
+	} else {
+		affected, err := res.RowsAffected()
+		if err != nil {
+			Logger.Errorf("Error during checking rows affected %v", err)
+			return false, err
+		}
+		return affected > 0, nil
+	}
+}
+
+// GetChatsToPurge returns the chats deleted more than the retention ago
+func (db *DB) GetChatsToPurge(retention time.Duration, limit int) ([]int64, error) {
+	rows, err := db.Query("SELECT id FROM chat WHERE delete_date_time <= utc_now() - make_interval(secs => $1) ORDER BY delete_date_time LIMIT $2", retention.Seconds(), limit)
+	if err != nil {
+		Logger.Errorf("Error during getting chats to purge %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+	list := make([]int64, 0)
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			Logger.Errorf("Error during scan chat rows %v", err)
+			return nil, err
+		}
+		list = append(list, id)
+	}
+	return list, nil
+}
+
+// DeleteChat deletes the chat with its messages for good
 func (tx *Tx) DeleteChat(id int64) error {
 	if _, err := tx.Exec(fmt.Sprintf(`DROP TABLE message_chat_%v;`, id)); err != nil {
 		Logger.Errorf("Error during drop message table %v %v", id, err)
@@ -237,6 +375,97 @@ func (tx *Tx) DeleteChat(id int64) error {
 	}
 }
 
+// SetChatVisibility doesn't touch tet-a-tet chats, they are always private
+func (tx *Tx) SetChatVisibility(id int64, visibility string) error {
+	if _, err := tx.Exec(`UPDATE chat SET visibility = $2 WHERE id = $1 AND tet_a_tet = false`, id, visibility); err != nil {
+		Logger.Errorf("Error during set chat visibility %v", err)
+		return err
+	}
+	return nil
+}
+
+// GetChatVisibility returns an empty string if the chat doesn't exist or is deleted
+func (tx *Tx) GetChatVisibility(id int64) (string, error) {
+	var visibility string
+	row := tx.QueryRow(`SELECT visibility FROM chat WHERE id = $1 AND delete_date_time IS NULL`, id)
+	err := row.Scan(&visibility)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		Logger.Errorf("Error during get chat visibility %v", err)
+		return "", err
+	}
+	return visibility, nil
+}
+
+// SetChatChannel doesn't touch tet-a-tet chats, they can't be channels
+func (tx *Tx) SetChatChannel(id int64, channel bool) error {
+	if _, err := tx.Exec(`UPDATE chat SET channel = $2 WHERE id = $1 AND tet_a_tet = false`, id, channel); err != nil {
+		Logger.Errorf("Error during set chat channel %v", err)
+		return err
+	}
+	return nil
+}
+
+func isChatChannelCommon(co CommonOperations, id int64) (bool, error) {
+	var channel bool
+	row := co.QueryRow(`SELECT channel FROM chat WHERE id = $1`, id)
+	err := row.Scan(&channel)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		Logger.Errorf("Error during checking is chat channel %v", err)
+		return false, err
+	}
+	return channel, nil
+}
+
+func (db *DB) IsChatChannel(id int64) (bool, error) {
+	return isChatChannelCommon(db, id)
+}
+
+func (tx *Tx) IsChatChannel(id int64) (bool, error) {
+	return isChatChannelCommon(tx, id)
+}
+
+type PublicChat struct {
+	Chat
+	ParticipantsCount int
+}
+
+// GetPublicChats is the directory of the public chats, it is available without authentication
+func (db *DB) GetPublicChats(limit, offset int, searchString string) ([]*PublicChat, error) {
+	rows, err := db.Query(`SELECT c.id, c.title, c.avatar, c.avatar_big, c.last_update_date_time, c.tet_a_tet, c.visibility, c.channel, (SELECT count(*) FROM chat_participant cp WHERE cp.chat_id = c.id) FROM chat c WHERE c.delete_date_time IS NULL AND c.visibility = $1 AND c.title ILIKE $4 ORDER BY (c.last_update_date_time, c.id) DESC LIMIT $2 OFFSET $3`, ChatVisibilityPublic, limit, offset, "%"+searchString+"%")
+	if err != nil {
+		Logger.Errorf("Error during get public chat rows %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+	list := make([]*PublicChat, 0)
+	for rows.Next() {
+		chat := PublicChat{}
+		if err := rows.Scan(&chat.Id, &chat.Title, &chat.Avatar, &chat.AvatarBig, &chat.LastUpdateDateTime, &chat.TetATet, &chat.Visibility, &chat.Channel, &chat.ParticipantsCount); err != nil {
+			Logger.Errorf("Error during scan public chat rows %v", err)
+			return nil, err
+		} else {
+			list = append(list, &chat)
+		}
+	}
+	return list, nil
+}
+
+func (db *DB) CountPublicChats(searchString string) (int64, error) {
+	var count int64
+	row := db.QueryRow("SELECT count(*) FROM chat WHERE delete_date_time IS NULL AND visibility = $1 AND title ILIKE $2", ChatVisibilityPublic, "%"+searchString+"%")
+	if err := row.Scan(&count); err != nil {
+		Logger.Errorf("Error during count public chats %v", err)
+		return 0, err
+	}
+	return count, nil
+}
+
 func (tx *Tx) EditChat(id int64, newTitle string, avatar, avatarBig null.String) (*time.Time, error) {
 
 	if res, err := tx.Exec(`UPDATE chat SET title = $2, avatar = $3, avatar_big = $4, last_update_date_time = utc_now() WHERE id = $1`, id, newTitle, avatar, avatarBig); err != nil {
@@ -264,9 +493,9 @@ func (tx *Tx) EditChat(id int64, newTitle string, avatar, avatarBig null.String)
 }
 
 func getChatCommon(co CommonOperations, participantId, chatId int64) (*Chat, error) {
-	row := co.QueryRow(`SELECT id, title, avatar, avatar_big, last_update_date_time, tet_a_tet FROM chat WHERE chat.id in (SELECT chat_id FROM chat_participant WHERE user_id = $2 AND chat_id = $1)`, chatId, participantId)
+	row := co.QueryRow(`SELECT c.id, c.title, c.avatar, c.avatar_big, c.last_update_date_time, c.tet_a_tet, cp.pinned, c.visibility, c.channel FROM chat c JOIN chat_participant cp ON cp.chat_id = c.id AND cp.user_id = $2 WHERE c.id = $1 AND c.delete_date_time IS NULL`, chatId, participantId)
 	chat := Chat{}
-	err := row.Scan(&chat.Id, &chat.Title, &chat.Avatar, &chat.AvatarBig, &chat.LastUpdateDateTime, &chat.TetATet)
+	err := row.Scan(&chat.Id, &chat.Title, &chat.Avatar, &chat.AvatarBig, &chat.LastUpdateDateTime, &chat.TetATet, &chat.Pinned, &chat.Visibility, &chat.Channel)
 	if errors.Is(err, sql.ErrNoRows) {
 		// there were no rows, but otherwise no error occurred
 		return nil, nil
@@ -296,6 +525,7 @@ func (tx *Tx) UpdateChatLastDatetimeChat(id int64) error {
 	}
 }
 
+// IsChatExists reports the soft-deleted chats as existing, so their files are kept until the purge
 func (db *DB) IsChatExists(chatId int64) (bool, error) {
 	row := db.QueryRow(`SELECT EXISTS(SELECT 1 FROM chat WHERE id = $1)`, chatId)
 	exists := false
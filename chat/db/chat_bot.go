@@ -0,0 +1,201 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/guregu/null"
+	. "nkonev.name/chat/logger"
+	"time"
+)
+
+// db model
+
+type Bot struct {
+	Id             int64
+	Name           string
+	Avatar         null.String
+	CreatorId      int64
+	CreateDateTime time.Time
+	TokenHash      null.String // the bot of an incoming webhook has no api token
+}
+
+type BotEvent struct {
+	Id             int64
+	BotId          int64
+	EventType      string
+	Payload        string
+	CreateDateTime time.Time
+}
+
+// the older events are removed, the bot which hasn't polled for long loses them
+const botEventsKept = 1000
+
+const selectBotClause = `SELECT id, name, avatar, creator_id, create_date_time, token_hash FROM chat_bot `
+
+func provideScanToBot(bot *Bot) []interface{} {
+	return []interface{}{&bot.Id, &bot.Name, &bot.Avatar, &bot.CreatorId, &bot.CreateDateTime, &bot.TokenHash}
+}
+
+// BotOwnerId is the owner id of the bot's messages
+func BotOwnerId(botId int64) int64 {
+	return -botId
+}
+
+// IsBotOwnerId tells is the message written by a bot, the users of aaa have the positive ids
+func IsBotOwnerId(ownerId int64) bool {
+	return ownerId < 0
+}
+
+func (tx *Tx) CreateBot(bot *Bot) error {
+	res := tx.QueryRow(`INSERT INTO chat_bot (name, avatar, creator_id, token_hash) VALUES ($1, $2, $3, $4) RETURNING id, create_date_time`, bot.Name, bot.Avatar, bot.CreatorId, bot.TokenHash)
+	if err := res.Scan(&bot.Id, &bot.CreateDateTime); err != nil {
+		Logger.Errorf("Error during creating bot %v", err)
+		return err
+	}
+	return nil
+}
+
+// getBotsByOwnerIdsCommon skips the ids of the users and returns the bots keyed by their owner ids
+func getBotsByOwnerIdsCommon(co CommonOperations, ownerIds []int64) (map[int64]*Bot, error) {
+	var botIds = []int64{}
+	for _, ownerId := range ownerIds {
+		if IsBotOwnerId(ownerId) {
+			botIds = append(botIds, -ownerId)
+		}
+	}
+	var result = map[int64]*Bot{}
+	if len(botIds) == 0 {
+		return result, nil
+	}
+	rows, err := co.Query(selectBotClause+`WHERE id = ANY($1)`, botIds)
+	if err != nil {
+		Logger.Errorf("Error during get bots %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		bot := Bot{}
+		if err := rows.Scan(provideScanToBot(&bot)...); err != nil {
+			Logger.Errorf("Error during scan bot rows %v", err)
+			return nil, err
+		}
+		result[BotOwnerId(bot.Id)] = &bot
+	}
+	return result, nil
+}
+
+func (db *DB) GetBotsByOwnerIds(ownerIds []int64) (map[int64]*Bot, error) {
+	return getBotsByOwnerIdsCommon(db, ownerIds)
+}
+
+func (tx *Tx) GetBotsByOwnerIds(ownerIds []int64) (map[int64]*Bot, error) {
+	return getBotsByOwnerIdsCommon(tx, ownerIds)
+}
+
+// GetBotByTokenHash returns nil if there is no bot with such api token
+func (db *DB) GetBotByTokenHash(tokenHash string) (*Bot, error) {
+	bot := Bot{}
+	row := db.QueryRow(selectBotClause+`WHERE token_hash = $1`, tokenHash)
+	err := row.Scan(provideScanToBot(&bot)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		Logger.Errorf("Error during get bot by token %v", err)
+		return nil, err
+	}
+	return &bot, nil
+}
+
+// GetApiBotsByCreator returns the bots with the api token the user has created
+func (db *DB) GetApiBotsByCreator(creatorId int64) ([]*Bot, error) {
+	rows, err := db.Query(selectBotClause+`WHERE creator_id = $1 AND token_hash IS NOT NULL ORDER BY id`, creatorId)
+	if err != nil {
+		Logger.Errorf("Error during get bots %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+	list := make([]*Bot, 0)
+	for rows.Next() {
+		bot := Bot{}
+		if err := rows.Scan(provideScanToBot(&bot)...); err != nil {
+			Logger.Errorf("Error during scan bot rows %v", err)
+			return nil, err
+		} else {
+			list = append(list, &bot)
+		}
+	}
+	return list, nil
+}
+
+// SetBotTokenHash replaces the api token, it returns false if the user hasn't created such api bot
+func (tx *Tx) SetBotTokenHash(botId, creatorId int64, tokenHash string) (bool, error) {
+	res, err := tx.Exec(`UPDATE chat_bot SET token_hash = $3 WHERE id = $1 AND creator_id = $2 AND token_hash IS NOT NULL`, botId, creatorId, tokenHash)
+	if err != nil {
+		Logger.Errorf("Error during updating bot token %v", err)
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		Logger.Errorf("Error during checking rows affected %v", err)
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// CountApiBots counts the bots with the api token among the owner ids, the ids of the users are skipped
+func (tx *Tx) CountApiBots(ownerIds []int64) (int, error) {
+	var botIds = []int64{}
+	for _, ownerId := range ownerIds {
+		if IsBotOwnerId(ownerId) {
+			botIds = append(botIds, -ownerId)
+		}
+	}
+	var count int
+	row := tx.QueryRow(`SELECT count(*) FROM chat_bot WHERE id = ANY($1) AND token_hash IS NOT NULL`, botIds)
+	if err := row.Scan(&count); err != nil {
+		Logger.Errorf("Error during counting bots %v", err)
+		return 0, err
+	}
+	return count, nil
+}
+
+// AddBotEvent stores the event for the api bot and keeps only the last botEventsKept ones, the events for the rest bots are skipped
+func (db *DB) AddBotEvent(botId int64, eventType string, payload string) error {
+	return Transact(*db, func(tx *Tx) error {
+		res, err := tx.Exec(`INSERT INTO chat_bot_event (bot_id, event_type, payload) SELECT id, $2, $3 FROM chat_bot WHERE id = $1 AND token_hash IS NOT NULL`, botId, eventType, payload)
+		if err != nil {
+			Logger.Errorf("Error during adding bot event %v", err)
+			return err
+		}
+		if affected, err := res.RowsAffected(); err != nil || affected == 0 {
+			return err
+		}
+		if _, err := tx.Exec(`DELETE FROM chat_bot_event WHERE bot_id = $1 AND id < (SELECT id FROM chat_bot_event WHERE bot_id = $1 ORDER BY id DESC OFFSET $2 LIMIT 1)`, botId, botEventsKept-1); err != nil {
+			Logger.Errorf("Error during trimming bot events %v", err)
+			return err
+		}
+		return nil
+	})
+}
+
+// GetBotEvents returns the events after the given one, the oldest first
+func (db *DB) GetBotEvents(botId, afterId int64, limit int) ([]*BotEvent, error) {
+	rows, err := db.Query(`SELECT id, bot_id, event_type, payload, create_date_time FROM chat_bot_event WHERE bot_id = $1 AND id > $2 ORDER BY id LIMIT $3`, botId, afterId, limit)
+	if err != nil {
+		Logger.Errorf("Error during get bot events %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+	list := make([]*BotEvent, 0)
+	for rows.Next() {
+		event := BotEvent{}
+		if err := rows.Scan(&event.Id, &event.BotId, &event.EventType, &event.Payload, &event.CreateDateTime); err != nil {
+			Logger.Errorf("Error during scan bot event rows %v", err)
+			return nil, err
+		} else {
+			list = append(list, &event)
+		}
+	}
+	return list, nil
+}
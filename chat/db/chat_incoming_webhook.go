@@ -0,0 +1,84 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	. "nkonev.name/chat/logger"
+	"time"
+)
+
+// db model
+
+type IncomingWebhook struct {
+	Token          string
+	ChatId         int64
+	BotId          int64
+	BotName        string // is only read
+	CreatorId      int64
+	CreateDateTime time.Time
+}
+
+const selectIncomingWebhookClause = `SELECT h.token, h.chat_id, h.bot_id, b.name, h.creator_id, h.create_date_time FROM chat_incoming_webhook h JOIN chat_bot b ON b.id = h.bot_id `
+
+func provideScanToIncomingWebhook(hook *IncomingWebhook) []interface{} {
+	return []interface{}{&hook.Token, &hook.ChatId, &hook.BotId, &hook.BotName, &hook.CreatorId, &hook.CreateDateTime}
+}
+
+func (tx *Tx) CreateIncomingWebhook(hook *IncomingWebhook) error {
+	res := tx.QueryRow(`INSERT INTO chat_incoming_webhook (token, chat_id, bot_id, creator_id) VALUES ($1, $2, $3, $4) RETURNING create_date_time`, hook.Token, hook.ChatId, hook.BotId, hook.CreatorId)
+	if err := res.Scan(&hook.CreateDateTime); err != nil {
+		Logger.Errorf("Error during creating incoming webhook %v", err)
+		return err
+	}
+	return nil
+}
+
+func (db *DB) GetIncomingWebhooks(chatId int64) ([]*IncomingWebhook, error) {
+	rows, err := db.Query(selectIncomingWebhookClause+`WHERE h.chat_id = $1 ORDER BY h.create_date_time`, chatId)
+	if err != nil {
+		Logger.Errorf("Error during get incoming webhooks %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+	list := make([]*IncomingWebhook, 0)
+	for rows.Next() {
+		hook := IncomingWebhook{}
+		if err := rows.Scan(provideScanToIncomingWebhook(&hook)...); err != nil {
+			Logger.Errorf("Error during scan incoming webhook rows %v", err)
+			return nil, err
+		} else {
+			list = append(list, &hook)
+		}
+	}
+	return list, nil
+}
+
+// GetIncomingWebhook returns nil if there is no such token or the chat is deleted
+func (db *DB) GetIncomingWebhook(token string) (*IncomingWebhook, error) {
+	hook := IncomingWebhook{}
+	row := db.QueryRow(selectIncomingWebhookClause+`JOIN chat c ON c.id = h.chat_id WHERE h.token = $1 AND c.delete_date_time IS NULL`, token)
+	err := row.Scan(provideScanToIncomingWebhook(&hook)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		Logger.Errorf("Error during get incoming webhook %v", err)
+		return nil, err
+	}
+	return &hook, nil
+}
+
+// DeleteIncomingWebhook returns false if there is no such webhook in the chat
+func (tx *Tx) DeleteIncomingWebhook(chatId int64, token string) (bool, error) {
+	res, err := tx.Exec(`DELETE FROM chat_incoming_webhook WHERE chat_id = $1 AND token = $2`, chatId, token)
+	if err != nil {
+		Logger.Errorf("Error during delete incoming webhook %v", err)
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		Logger.Errorf("Error during checking rows affected %v", err)
+		return false, err
+	}
+	return affected > 0, nil
+}
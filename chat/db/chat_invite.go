@@ -0,0 +1,109 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/guregu/null"
+	. "nkonev.name/chat/logger"
+	"time"
+)
+
+// db model
+
+type ChatInvite struct {
+	Token          string
+	ChatId         int64
+	CreatorId      int64
+	CreateDateTime time.Time
+	ExpireDateTime null.Time
+	MaxUses        null.Int
+	Uses           int64
+}
+
+const selectChatInviteClause = `SELECT token, chat_id, creator_id, create_date_time, expire_date_time, max_uses, uses FROM chat_invite `
+
+func provideScanToChatInvite(invite *ChatInvite) []interface{} {
+	return []interface{}{
+		&invite.Token,
+		&invite.ChatId,
+		&invite.CreatorId,
+		&invite.CreateDateTime,
+		&invite.ExpireDateTime,
+		&invite.MaxUses,
+		&invite.Uses,
+	}
+}
+
+func (tx *Tx) CreateChatInvite(invite *ChatInvite) error {
+	res := tx.QueryRow(`INSERT INTO chat_invite (token, chat_id, creator_id, expire_date_time, max_uses) VALUES ($1, $2, $3, $4, $5) RETURNING create_date_time`, invite.Token, invite.ChatId, invite.CreatorId, invite.ExpireDateTime, invite.MaxUses)
+	if err := res.Scan(&invite.CreateDateTime); err != nil {
+		Logger.Errorf("Error during creating chat invite %v", err)
+		return err
+	}
+	return nil
+}
+
+func (db *DB) GetChatInvites(chatId int64) ([]*ChatInvite, error) {
+	rows, err := db.Query(selectChatInviteClause+`WHERE chat_id = $1 ORDER BY create_date_time DESC`, chatId)
+	if err != nil {
+		Logger.Errorf("Error during get chat invites %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+	list := make([]*ChatInvite, 0)
+	for rows.Next() {
+		invite := ChatInvite{}
+		if err := rows.Scan(provideScanToChatInvite(&invite)...); err != nil {
+			Logger.Errorf("Error during scan chat invite rows %v", err)
+			return nil, err
+		} else {
+			list = append(list, &invite)
+		}
+	}
+	return list, nil
+}
+
+// GetChatInvite returns nil if there is no such invite
+func (tx *Tx) GetChatInvite(token string) (*ChatInvite, error) {
+	invite := ChatInvite{}
+	row := tx.QueryRow(selectChatInviteClause+`WHERE token = $1`, token)
+	err := row.Scan(provideScanToChatInvite(&invite)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		Logger.Errorf("Error during get chat invite %v", err)
+		return nil, err
+	}
+	return &invite, nil
+}
+
+func (tx *Tx) DeleteChatInvite(chatId int64, token string) (bool, error) {
+	res, err := tx.Exec(`DELETE FROM chat_invite WHERE chat_id = $1 AND token = $2`, chatId, token)
+	if err != nil {
+		Logger.Errorf("Error during delete chat invite %v", err)
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		Logger.Errorf("Error during checking rows affected %v", err)
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// UseChatInvite takes one use of the invite, it returns false if the invite is expired or used up.
+// The check and the increment are the one statement, so the concurrent joins don't exceed max uses
+func (tx *Tx) UseChatInvite(token string) (bool, error) {
+	res, err := tx.Exec(`UPDATE chat_invite SET uses = uses + 1 WHERE token = $1 AND (expire_date_time IS NULL OR expire_date_time > utc_now()) AND (max_uses IS NULL OR uses < max_uses)`, token)
+	if err != nil {
+		Logger.Errorf("Error during use chat invite %v", err)
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		Logger.Errorf("Error during checking rows affected %v", err)
+		return false, err
+	}
+	return affected > 0, nil
+}
@@ -0,0 +1,62 @@
+package db
+
+import (
+	"github.com/guregu/null"
+	. "nkonev.name/chat/logger"
+	"time"
+)
+
+// db model
+
+type LinkPreview struct {
+	Url           string
+	Success       bool // the failed fetch is cached to not repeat it until it's stale
+	Title         null.String
+	Description   null.String
+	ImageUrl      null.String
+	SiteName      null.String
+	FetchDateTime time.Time
+}
+
+func getLinkPreviewsCommon(co CommonOperations, urls []string) (map[string]*LinkPreview, error) {
+	var result = map[string]*LinkPreview{}
+	if len(urls) == 0 {
+		return result, nil
+	}
+	rows, err := co.Query(`SELECT url, success, title, description, image_url, site_name, fetch_date_time FROM chat_link_preview WHERE url = ANY($1)`, urls)
+	if err != nil {
+		Logger.Errorf("Error during get link previews %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		preview := LinkPreview{}
+		if err := rows.Scan(&preview.Url, &preview.Success, &preview.Title, &preview.Description, &preview.ImageUrl, &preview.SiteName, &preview.FetchDateTime); err != nil {
+			Logger.Errorf("Error during scan link preview rows %v", err)
+			return nil, err
+		}
+		result[preview.Url] = &preview
+	}
+	return result, nil
+}
+
+// GetLinkPreviews returns the cached previews keyed by url, including the failed and the stale ones
+func (db *DB) GetLinkPreviews(urls []string) (map[string]*LinkPreview, error) {
+	return getLinkPreviewsCommon(db, urls)
+}
+
+func (tx *Tx) GetLinkPreviews(urls []string) (map[string]*LinkPreview, error) {
+	return getLinkPreviewsCommon(tx, urls)
+}
+
+// SaveLinkPreview replaces the cached preview of the url
+func (db *DB) SaveLinkPreview(preview *LinkPreview) error {
+	_, err := db.Exec(`INSERT INTO chat_link_preview (url, success, title, description, image_url, site_name) VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (url) DO UPDATE SET success = excluded.success, title = excluded.title, description = excluded.description, image_url = excluded.image_url, site_name = excluded.site_name, fetch_date_time = utc_now()`,
+		preview.Url, preview.Success, preview.Title, preview.Description, preview.ImageUrl, preview.SiteName)
+	if err != nil {
+		Logger.Errorf("Error during saving link preview %v", err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package db
+
+import (
+	"database/sql"
+	. "nkonev.name/chat/logger"
+)
+
+// db model
+
+// ChatSettings are the participant's own settings of the chat
+type ChatSettings struct {
+	Muted    bool
+	Archived bool
+}
+
+// GetChatSettings returns nil if the user isn't a participant of the chat
+func (db *DB) GetChatSettings(userId, chatId int64) (*ChatSettings, error) {
+	settings := ChatSettings{}
+	row := db.QueryRow(`SELECT muted, archived FROM chat_participant WHERE user_id = $1 AND chat_id = $2`, userId, chatId)
+	err := row.Scan(&settings.Muted, &settings.Archived)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		Logger.Errorf("Error during get chat settings %v", err)
+		return nil, err
+	}
+	return &settings, nil
+}
+
+func (tx *Tx) SetChatSettings(userId, chatId int64, settings *ChatSettings) error {
+	_, err := tx.Exec(`UPDATE chat_participant SET muted = $3, archived = $4 WHERE user_id = $1 AND chat_id = $2`, userId, chatId, settings.Muted, settings.Archived)
+	if err != nil {
+		Logger.Errorf("Error during set chat settings %v", err)
+	}
+	return err
+}
+
+func (tx *Tx) PinChat(userId, chatId int64, pin bool) error {
+	_, err := tx.Exec(`UPDATE chat_participant SET pinned = $3 WHERE user_id = $1 AND chat_id = $2`, userId, chatId, pin)
+	if err != nil {
+		Logger.Errorf("Error during pin chat %v", err)
+	}
+	return err
+}
+
+// GetChatPinnedBy returns which of the given users have pinned the chat
+func (tx *Tx) GetChatPinnedBy(chatId int64, userIds []int64) (map[int64]bool, error) {
+	res := map[int64]bool{}
+	rows, err := tx.Query(`SELECT user_id FROM chat_participant WHERE chat_id = $1 AND user_id = ANY($2) AND pinned = true`, chatId, userIds)
+	if err != nil {
+		Logger.Errorf("Error during checking is chat pinned %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var userId int64
+		if err := rows.Scan(&userId); err != nil {
+			Logger.Errorf("Error during scan pinned rows %v", err)
+			return nil, err
+		}
+		res[userId] = true
+	}
+	return res, nil
+}
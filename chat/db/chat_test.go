@@ -0,0 +1,66 @@
+package db
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// creates the chats where the user is admin in the every second one and the amount of participants differs
+func createChatsWithParticipants(t *testing.T, behalfUserId int64, chatsCount int) []*Chat {
+	dbInstance.Migrate(MigrationsConfig{})
+
+	var chats = make([]*Chat, 0)
+	err := Transact(*dbInstance, func(tx *Tx) error {
+		for i := 0; i < chatsCount; i++ {
+			chat := &Chat{Title: fmt.Sprintf("batch_%v_%v", behalfUserId, i)}
+			id, lastUpdateDateTime, err := tx.CreateChat(chat)
+			if err != nil {
+				return err
+			}
+			chat.Id = id
+			chat.LastUpdateDateTime = *lastUpdateDateTime
+			role := "member"
+			if i%2 == 0 {
+				role = "admin"
+			}
+			if err := tx.AddParticipant(behalfUserId, id, role); err != nil {
+				return err
+			}
+			for j := 0; j < i; j++ {
+				if err := tx.AddParticipant(behalfUserId+int64(j)+1, id, "member"); err != nil {
+					return err
+				}
+			}
+			chats = append(chats, chat)
+		}
+		return nil
+	})
+	assert.Nil(t, err)
+	return chats
+}
+
+func TestConvertToWithParticipantsBatchMatchesPerChat(t *testing.T) {
+	const behalfUserId = 100
+	chats := createChatsWithParticipants(t, behalfUserId, 6)
+
+	for _, page := range []struct{ size, offset int }{{20, 0}, {2, 0}, {2, 3}} {
+		batch, err := convertToWithParticipantsBatch(dbInstance, chats, behalfUserId, page.size, page.offset)
+		assert.Nil(t, err)
+		assert.Equal(t, len(chats), len(batch))
+
+		for i, chat := range chats {
+			perChat, err := convertToWithParticipants(dbInstance, chat, behalfUserId, page.size, page.offset)
+			assert.Nil(t, err)
+			assert.Equal(t, perChat, batch[i], "chat %v, participants size %v offset %v", chat.Id, page.size, page.offset)
+		}
+	}
+}
+
+func TestConvertToWithParticipantsBatchEmpty(t *testing.T) {
+	dbInstance.Migrate(MigrationsConfig{})
+
+	batch, err := convertToWithParticipantsBatch(dbInstance, []*Chat{}, 1, 20, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(batch))
+}
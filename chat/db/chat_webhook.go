@@ -0,0 +1,155 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/guregu/null"
+	. "nkonev.name/chat/logger"
+	"strings"
+	"time"
+)
+
+// db model
+
+type ChatWebhook struct {
+	Id             int64
+	ChatId         int64
+	Url            string
+	Secret         string
+	EventTypes     []string // all the events if empty
+	CreatorId      int64
+	CreateDateTime time.Time
+}
+
+type ChatWebhookDelivery struct {
+	Id             int64
+	WebhookId      int64
+	EventType      string
+	Attempt        int
+	StatusCode     null.Int
+	Error          null.String
+	Success        bool
+	CreateDateTime time.Time
+}
+
+// the older deliveries are removed from the log
+const chatWebhookDeliveriesKept = 100
+
+// event_types is read as a string because database/sql can't scan an array
+const selectChatWebhookClause = `SELECT id, chat_id, url, secret, array_to_string(event_types, ','), creator_id, create_date_time FROM chat_webhook `
+
+func scanChatWebhook(scan func(dest ...interface{}) error) (*ChatWebhook, error) {
+	hook := ChatWebhook{}
+	var eventTypes string
+	if err := scan(&hook.Id, &hook.ChatId, &hook.Url, &hook.Secret, &eventTypes, &hook.CreatorId, &hook.CreateDateTime); err != nil {
+		return nil, err
+	}
+	hook.EventTypes = make([]string, 0)
+	if eventTypes != "" {
+		hook.EventTypes = strings.Split(eventTypes, ",")
+	}
+	return &hook, nil
+}
+
+func (tx *Tx) CreateChatWebhook(hook *ChatWebhook) error {
+	res := tx.QueryRow(`INSERT INTO chat_webhook (chat_id, url, secret, event_types, creator_id) VALUES ($1, $2, $3, $4, $5) RETURNING id, create_date_time`, hook.ChatId, hook.Url, hook.Secret, hook.EventTypes, hook.CreatorId)
+	if err := res.Scan(&hook.Id, &hook.CreateDateTime); err != nil {
+		Logger.Errorf("Error during creating chat webhook %v", err)
+		return err
+	}
+	return nil
+}
+
+func (db *DB) GetChatWebhooks(chatId int64) ([]*ChatWebhook, error) {
+	return getChatWebhooks(db, selectChatWebhookClause+`WHERE chat_id = $1 ORDER BY id`, chatId)
+}
+
+// GetChatWebhooksForEvent returns the webhooks of the chat which are subscribed to the event type
+func (db *DB) GetChatWebhooksForEvent(chatId int64, eventType string) ([]*ChatWebhook, error) {
+	return getChatWebhooks(db, selectChatWebhookClause+`WHERE chat_id = $1 AND (cardinality(event_types) = 0 OR $2 = ANY(event_types)) ORDER BY id`, chatId, eventType)
+}
+
+func getChatWebhooks(co CommonOperations, query string, args ...interface{}) ([]*ChatWebhook, error) {
+	rows, err := co.Query(query, args...)
+	if err != nil {
+		Logger.Errorf("Error during get chat webhooks %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+	list := make([]*ChatWebhook, 0)
+	for rows.Next() {
+		if hook, err := scanChatWebhook(rows.Scan); err != nil {
+			Logger.Errorf("Error during scan chat webhook rows %v", err)
+			return nil, err
+		} else {
+			list = append(list, hook)
+		}
+	}
+	return list, nil
+}
+
+// GetChatWebhook returns nil if there is no such webhook in the chat
+func (db *DB) GetChatWebhook(chatId, hookId int64) (*ChatWebhook, error) {
+	row := db.QueryRow(selectChatWebhookClause+`WHERE chat_id = $1 AND id = $2`, chatId, hookId)
+	hook, err := scanChatWebhook(row.Scan)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		Logger.Errorf("Error during get chat webhook %v", err)
+		return nil, err
+	}
+	return hook, nil
+}
+
+// DeleteChatWebhook returns false if there is no such webhook in the chat
+func (tx *Tx) DeleteChatWebhook(chatId, hookId int64) (bool, error) {
+	res, err := tx.Exec(`DELETE FROM chat_webhook WHERE chat_id = $1 AND id = $2`, chatId, hookId)
+	if err != nil {
+		Logger.Errorf("Error during delete chat webhook %v", err)
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		Logger.Errorf("Error during checking rows affected %v", err)
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// AddChatWebhookDelivery logs the attempt and keeps only the last chatWebhookDeliveriesKept ones
+func (db *DB) AddChatWebhookDelivery(delivery *ChatWebhookDelivery) error {
+	return Transact(*db, func(tx *Tx) error {
+		res := tx.QueryRow(`INSERT INTO chat_webhook_delivery (webhook_id, event_type, attempt, status_code, error, success) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id, create_date_time`, delivery.WebhookId, delivery.EventType, delivery.Attempt, delivery.StatusCode, delivery.Error, delivery.Success)
+		if err := res.Scan(&delivery.Id, &delivery.CreateDateTime); err != nil {
+			Logger.Errorf("Error during adding chat webhook delivery %v", err)
+			return err
+		}
+		if _, err := tx.Exec(`DELETE FROM chat_webhook_delivery WHERE webhook_id = $1 AND id < (SELECT id FROM chat_webhook_delivery WHERE webhook_id = $1 ORDER BY id DESC OFFSET $2 LIMIT 1)`, delivery.WebhookId, chatWebhookDeliveriesKept-1); err != nil {
+			Logger.Errorf("Error during trimming chat webhook deliveries %v", err)
+			return err
+		}
+		return nil
+	})
+}
+
+// GetChatWebhookDeliveries returns the newest deliveries first
+func (db *DB) GetChatWebhookDeliveries(hookId int64, limit, offset int) ([]*ChatWebhookDelivery, error) {
+	rows, err := db.Query(`SELECT id, webhook_id, event_type, attempt, status_code, error, success, create_date_time FROM chat_webhook_delivery WHERE webhook_id = $1 ORDER BY id DESC LIMIT $2 OFFSET $3`, hookId, limit, offset)
+	if err != nil {
+		Logger.Errorf("Error during get chat webhook deliveries %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+	list := make([]*ChatWebhookDelivery, 0)
+	for rows.Next() {
+		delivery := ChatWebhookDelivery{}
+		if err := rows.Scan(&delivery.Id, &delivery.WebhookId, &delivery.EventType, &delivery.Attempt, &delivery.StatusCode, &delivery.Error, &delivery.Success, &delivery.CreateDateTime); err != nil {
+			Logger.Errorf("Error during scan chat webhook delivery rows %v", err)
+			return nil, err
+		} else {
+			list = append(list, &delivery)
+		}
+	}
+	return list, nil
+}
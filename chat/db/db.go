@@ -13,6 +13,7 @@ import (
 	"go.uber.org/fx"
 	"net/http"
 	. "nkonev.name/chat/logger"
+	"sync/atomic"
 	"time"
 )
 
@@ -23,6 +24,7 @@ type DB struct {
 
 type Tx struct {
 	*sql.Tx
+	afterCommit []func()
 }
 
 type MigrationsConfig struct {
@@ -38,35 +40,56 @@ type CommonOperations interface {
 	GetAllParticipantIds(chatId int64) ([]int64, error)
 	GetParticipantsCount(chatId int64) (int, error)
 	IsAdmin(userId int64, chatId int64) (bool, error)
+	GetParticipantRole(userId int64, chatId int64) (string, error)
 	GetChat(participantId, chatId int64) (*Chat, error)
+	IsChatChannel(id int64) (bool, error)
+	GetBotsByOwnerIds(ownerIds []int64) (map[int64]*Bot, error)
+	GetLinkPreviews(urls []string) (map[string]*LinkPreview, error)
 	GetChatWithParticipants(behalfParticipantId, chatId int64, participantsSize, participantsOffset int) (*ChatWithParticipants, error)
 	GetMessage(chatId int64, userId int64, messageId int64) (*Message, error)
+	GetMessagesByIds(chatId int64, messageIds []int64) (map[int64]*Message, error)
+	GetReactions(chatId int64, messageIds []int64) (map[int64][]*ReactionCount, error)
+	GetReadByCounts(chatId int64, messageIds []int64) (map[int64]int64, error)
 	GetUnreadMessagesCount(chatId int64, userId int64) (int64, error)
 	GetAllUnreadMessagesCount(chatId int64) (int64, error)
-	SetAdmin(userId int64, chatId int64, newAdmin bool) error
+	GetUnreadMentionsCount(chatId int64, userId int64) (int64, error)
+	SetParticipantRole(userId int64, chatId int64, role string) error
+}
+
+// counts the queries of the both DB and Tx, the benchmarks check it doesn't grow with the amount of the data
+var queriesCount atomic.Int64
+
+func QueriesCount() int64 {
+	return queriesCount.Load()
 }
 
 func (dbR *DB) Query(query string, args ...interface{}) (*dbP.Rows, error) {
+	queriesCount.Add(1)
 	return dbR.DB.Query(query, args...)
 }
 
 func (txR *Tx) Query(query string, args ...interface{}) (*dbP.Rows, error) {
+	queriesCount.Add(1)
 	return txR.Tx.Query(query, args...)
 }
 
 func (dbR *DB) QueryRow(query string, args ...interface{}) *dbP.Row {
+	queriesCount.Add(1)
 	return dbR.DB.QueryRow(query, args...)
 }
 
 func (txR *Tx) QueryRow(query string, args ...interface{}) *dbP.Row {
+	queriesCount.Add(1)
 	return txR.Tx.QueryRow(query, args...)
 }
 
 func (dbR *DB) Exec(query string, args ...interface{}) (sql.Result, error) {
+	queriesCount.Add(1)
 	return dbR.DB.Exec(query, args...)
 }
 
 func (txR *Tx) Exec(query string, args ...interface{}) (sql.Result, error) {
+	queriesCount.Add(1)
 	return txR.Tx.Exec(query, args...)
 }
 
@@ -89,7 +112,18 @@ func (db *DB) Begin() (*Tx, error) {
 	if tx, err := db.DB.Begin(); err != nil {
 		return nil, err
 	} else {
-		return &Tx{tx}, nil
+		return &Tx{Tx: tx}, nil
+	}
+}
+
+// AfterCommit runs f after Transact has committed the transaction, e.g. to start the background work which reads the saved data
+func (tx *Tx) AfterCommit(f func()) {
+	tx.afterCommit = append(tx.afterCommit, f)
+}
+
+func (tx *Tx) runAfterCommit() {
+	for _, f := range tx.afterCommit {
+		f()
 	}
 }
 
@@ -0,0 +1,131 @@
+package db
+
+import (
+	. "nkonev.name/chat/logger"
+	"time"
+)
+
+// db model
+
+type Mention struct {
+	ChatId         int64
+	MessageId      int64
+	OwnerId        int64
+	Text           string
+	CreateDateTime time.Time
+}
+
+// SetMessageMentions replaces the mentioned users of the message and returns the newly mentioned ones
+func (tx *Tx) SetMessageMentions(chatId, messageId int64, userIds []int64) ([]int64, error) {
+	if userIds == nil {
+		// nil is NULL for ANY()
+		userIds = []int64{}
+	}
+	if _, err := tx.Exec(`DELETE FROM message_mention WHERE chat_id = $1 AND message_id = $2 AND NOT (user_id = ANY($3))`, chatId, messageId, userIds); err != nil {
+		Logger.Errorf("Error during deleting message mentions %v", err)
+		return nil, err
+	}
+
+	var added = make([]int64, 0)
+	if len(userIds) == 0 {
+		return added, nil
+	}
+	rows, err := tx.Query(`INSERT INTO message_mention (chat_id, message_id, user_id) SELECT $1, $2, unnest($3::bigint[]) ON CONFLICT DO NOTHING RETURNING user_id`, chatId, messageId, userIds)
+	if err != nil {
+		Logger.Errorf("Error during inserting message mentions %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var userId int64
+		if err := rows.Scan(&userId); err != nil {
+			Logger.Errorf("Error during scan mention rows %v", err)
+			return nil, err
+		} else {
+			added = append(added, userId)
+		}
+	}
+	return added, nil
+}
+
+func (tx *Tx) DeleteMessageMentions(chatId, messageId int64) error {
+	_, err := tx.Exec(`DELETE FROM message_mention WHERE chat_id = $1 AND message_id = $2`, chatId, messageId)
+	if err != nil {
+		Logger.Errorf("Error during deleting message mentions %v", err)
+	}
+	return err
+}
+
+// mentions of messages which are after the user's last read message
+const unreadMentionsClause = `mm.user_id = $1 AND mm.message_id > COALESCE((SELECT last_message_id FROM message_read mr WHERE mr.user_id = $1 AND mr.chat_id = mm.chat_id), 0)`
+
+// GetUnreadMentions returns the unread mentions of the user over all the user's chats, the newest first
+func (db *DB) GetUnreadMentions(userId int64, limit, offset int) ([]*Mention, error) {
+	rows, err := db.Query(`
+		SELECT mm.chat_id, m.id, m.owner_id, m.text, m.create_date_time
+		FROM message_mention mm
+		JOIN chat_participant cp ON cp.user_id = mm.user_id AND cp.chat_id = mm.chat_id
+		JOIN message m ON m.tableoid = ('message_chat_' || mm.chat_id)::regclass AND m.id = mm.message_id
+		WHERE `+unreadMentionsClause+`
+		ORDER BY m.create_date_time DESC, mm.chat_id DESC, m.id DESC
+		LIMIT $2 OFFSET $3`,
+		userId, limit, offset)
+	if err != nil {
+		Logger.Errorf("Error during get unread mentions %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+	list := make([]*Mention, 0)
+	for rows.Next() {
+		mention := new(Mention)
+		if err := rows.Scan(&mention.ChatId, &mention.MessageId, &mention.OwnerId, &mention.Text, &mention.CreateDateTime); err != nil {
+			Logger.Errorf("Error during scan mention rows %v", err)
+			return nil, err
+		} else {
+			list = append(list, mention)
+		}
+	}
+	return list, nil
+}
+
+func getUnreadMentionsCountCommon(co CommonOperations, chatId int64, userId int64) (int64, error) {
+	var count int64
+	row := co.QueryRow(`SELECT count(*) FROM message_mention mm WHERE mm.chat_id = $2 AND `+unreadMentionsClause, userId, chatId)
+	if err := row.Scan(&count); err != nil {
+		Logger.Errorf("Error during get unread mentions count %v", err)
+		return 0, err
+	}
+	return count, nil
+}
+
+// GetUnreadMentionsCounts returns the unread mentions count for each of the given chats with the one query
+func (db *DB) GetUnreadMentionsCounts(chatIds []int64, userId int64) (map[int64]int64, error) {
+	res := map[int64]int64{}
+	if len(chatIds) == 0 {
+		return res, nil
+	}
+	rows, err := db.Query(`SELECT mm.chat_id, count(*) FROM message_mention mm WHERE mm.chat_id = ANY($2) AND `+unreadMentionsClause+` GROUP BY mm.chat_id`, userId, chatIds)
+	if err != nil {
+		Logger.Errorf("Error during get unread mentions counts %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var chatId, count int64
+		if err := rows.Scan(&chatId, &count); err != nil {
+			Logger.Errorf("Error during scan unread mentions counts %v", err)
+			return nil, err
+		} else {
+			res[chatId] = count
+		}
+	}
+	return res, nil
+}
+
+func (db *DB) GetUnreadMentionsCount(chatId int64, userId int64) (int64, error) {
+	return getUnreadMentionsCountCommon(db, chatId, userId)
+}
+
+func (tx *Tx) GetUnreadMentionsCount(chatId int64, userId int64) (int64, error) {
+	return getUnreadMentionsCountCommon(tx, chatId, userId)
+}
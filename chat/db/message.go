@@ -11,13 +11,39 @@ import (
 )
 
 type Message struct {
-	Id             int64
-	Text           string
-	ChatId         int64
-	OwnerId        int64
-	CreateDateTime time.Time
-	EditDateTime   null.Time
-	FileItemUuid   *uuid.UUID
+	Id               int64
+	Text             string
+	ChatId           int64
+	OwnerId          int64
+	CreateDateTime   time.Time
+	EditDateTime     null.Time
+	FileItemUuid     *uuid.UUID
+	ReplyToMessageId null.Int
+	Pinned           bool
+	// the original message, for the forwarded ones
+	ForwardedFromChatId    null.Int
+	ForwardedFromMessageId null.Int
+	ForwardedFromOwnerId   null.Int
+}
+
+func selectMessageClause(chatId int64) string {
+	return fmt.Sprintf(`SELECT m.id, m.text, m.owner_id, m.create_date_time, m.edit_date_time, m.file_item_uuid, m.reply_to_message_id, m.pinned, m.forwarded_from_chat_id, m.forwarded_from_message_id, m.forwarded_from_owner_id FROM message_chat_%v m `, chatId)
+}
+
+func provideScanToMessage(message *Message) []interface{} {
+	return []interface{}{
+		&message.Id,
+		&message.Text,
+		&message.OwnerId,
+		&message.CreateDateTime,
+		&message.EditDateTime,
+		&message.FileItemUuid,
+		&message.ReplyToMessageId,
+		&message.Pinned,
+		&message.ForwardedFromChatId,
+		&message.ForwardedFromMessageId,
+		&message.ForwardedFromOwnerId,
+	}
 }
 
 func (db *DB) GetMessages(chatId int64, userId int64, limit int, startingFromItemId int64, reverse bool, searchString string) ([]*Message, error) {
@@ -31,14 +57,13 @@ func (db *DB) GetMessages(chatId int64, userId int64, limit int, startingFromIte
 	var err error
 	var rows *sql.Rows
 	if searchString != "" {
-		searchString = "%" + searchString + "%"
-		rows, err = db.Query(fmt.Sprintf(`SELECT m.id, m.text, m.owner_id, m.create_date_time, m.edit_date_time, m.file_item_uuid FROM message_chat_%v m WHERE $4 IN ( SELECT chat_id FROM chat_participant WHERE user_id = $1 AND chat_id = $4 ) AND %s AND strip_tags(m.text) ILIKE $5 ORDER BY id %s LIMIT $2`, chatId, nonEquality, order), userId, limit, startingFromItemId, chatId, searchString)
+		rows, err = db.Query(fmt.Sprintf(`%s WHERE $4 IN ( SELECT chat_id FROM chat_participant JOIN chat ON chat.id = chat_id WHERE user_id = $1 AND chat_id = $4 AND chat.delete_date_time IS NULL ) AND %s AND m.text_search @@ websearch_to_tsquery($6::regconfig, $5) ORDER BY id %s LIMIT $2`, selectMessageClause(chatId), nonEquality, order), userId, limit, startingFromItemId, chatId, searchString, searchConfig(searchString))
 		if err != nil {
 			Logger.Errorf("Error during get chat rows %v", err)
 			return nil, err
 		}
 	} else {
-		rows, err = db.Query(fmt.Sprintf(`SELECT m.id, m.text, m.owner_id, m.create_date_time, m.edit_date_time, m.file_item_uuid FROM message_chat_%v m WHERE $4 IN ( SELECT chat_id FROM chat_participant WHERE user_id = $1 AND chat_id = $4 ) AND %s ORDER BY id %s LIMIT $2`, chatId, nonEquality, order), userId, limit, startingFromItemId, chatId)
+		rows, err = db.Query(fmt.Sprintf(`%s WHERE $4 IN ( SELECT chat_id FROM chat_participant JOIN chat ON chat.id = chat_id WHERE user_id = $1 AND chat_id = $4 AND chat.delete_date_time IS NULL ) AND %s ORDER BY id %s LIMIT $2`, selectMessageClause(chatId), nonEquality, order), userId, limit, startingFromItemId, chatId)
 		if err != nil {
 			Logger.Errorf("Error during get chat rows with search %v", err)
 			return nil, err
@@ -49,7 +74,36 @@ func (db *DB) GetMessages(chatId int64, userId int64, limit int, startingFromIte
 	list := make([]*Message, 0)
 	for rows.Next() {
 		message := Message{ChatId: chatId}
-		if err := rows.Scan(&message.Id, &message.Text, &message.OwnerId, &message.CreateDateTime, &message.EditDateTime, &message.FileItemUuid); err != nil {
+		if err := rows.Scan(provideScanToMessage(&message)...); err != nil {
+			Logger.Errorf("Error during scan message rows %v", err)
+			return nil, err
+		} else {
+			list = append(list, &message)
+		}
+	}
+	return list, nil
+}
+
+// GetThread returns replies (including nested ones) to the given message, ordered from the oldest
+func (db *DB) GetThread(chatId int64, userId int64, rootMessageId int64, limit int, startingFromItemId int64) ([]*Message, error) {
+	rows, err := db.Query(fmt.Sprintf(`
+		WITH RECURSIVE thread AS (
+			SELECT id FROM message_chat_%v WHERE reply_to_message_id = $3
+			UNION
+			SELECT r.id FROM message_chat_%v r JOIN thread t ON r.reply_to_message_id = t.id
+		)
+		%s WHERE $4 IN ( SELECT chat_id FROM chat_participant JOIN chat ON chat.id = chat_id WHERE user_id = $1 AND chat_id = $4 AND chat.delete_date_time IS NULL ) AND m.id IN (SELECT id FROM thread) AND m.id > $5 ORDER BY m.id ASC LIMIT $2`,
+		chatId, chatId, selectMessageClause(chatId)),
+		userId, limit, rootMessageId, chatId, startingFromItemId)
+	if err != nil {
+		Logger.Errorf("Error during get thread rows %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+	list := make([]*Message, 0)
+	for rows.Next() {
+		message := Message{ChatId: chatId}
+		if err := rows.Scan(provideScanToMessage(&message)...); err != nil {
 			Logger.Errorf("Error during scan message rows %v", err)
 			return nil, err
 		} else {
@@ -59,6 +113,38 @@ func (db *DB) GetMessages(chatId int64, userId int64, limit int, startingFromIte
 	return list, nil
 }
 
+func getMessagesByIdsCommon(co CommonOperations, chatId int64, messageIds []int64) (map[int64]*Message, error) {
+	res := map[int64]*Message{}
+	if len(messageIds) == 0 {
+		return res, nil
+	}
+	rows, err := co.Query(fmt.Sprintf(`%s WHERE m.id = ANY($1)`, selectMessageClause(chatId)), messageIds)
+	if err != nil {
+		Logger.Errorf("Error during get messages by ids %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		message := Message{ChatId: chatId}
+		if err := rows.Scan(provideScanToMessage(&message)...); err != nil {
+			Logger.Errorf("Error during scan message rows %v", err)
+			return nil, err
+		} else {
+			res[message.Id] = &message
+		}
+	}
+	return res, nil
+}
+
+// GetMessagesByIds doesn't check the participance, so it should be called after the caller's access is already checked
+func (db *DB) GetMessagesByIds(chatId int64, messageIds []int64) (map[int64]*Message, error) {
+	return getMessagesByIdsCommon(db, chatId, messageIds)
+}
+
+func (tx *Tx) GetMessagesByIds(chatId int64, messageIds []int64) (map[int64]*Message, error) {
+	return getMessagesByIdsCommon(tx, chatId, messageIds)
+}
+
 func (tx *Tx) CreateMessage(m *Message) (id int64, createDatetime time.Time, editDatetime null.Time, err error) {
 	if m == nil {
 		return id, createDatetime, editDatetime, errors.New("message required")
@@ -66,7 +152,7 @@ func (tx *Tx) CreateMessage(m *Message) (id int64, createDatetime time.Time, edi
 		return id, createDatetime, editDatetime, errors.New("text required")
 	}
 
-	res := tx.QueryRow(fmt.Sprintf(`INSERT INTO message_chat_%v (text, owner_id, file_item_uuid) VALUES ($1, $2, $3) RETURNING id, create_date_time, edit_date_time`, m.ChatId), m.Text, m.OwnerId, m.FileItemUuid)
+	res := tx.QueryRow(fmt.Sprintf(`INSERT INTO message_chat_%v (text, owner_id, file_item_uuid, reply_to_message_id, forwarded_from_chat_id, forwarded_from_message_id, forwarded_from_owner_id) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id, create_date_time, edit_date_time`, m.ChatId), m.Text, m.OwnerId, m.FileItemUuid, m.ReplyToMessageId, m.ForwardedFromChatId, m.ForwardedFromMessageId, m.ForwardedFromOwnerId)
 	if err := res.Scan(&id, &createDatetime, &editDatetime); err != nil {
 		Logger.Errorf("Error during getting message id %v", err)
 		return id, createDatetime, editDatetime, err
@@ -86,9 +172,9 @@ func (db *DB) CountMessages() (int64, error) {
 }
 
 func getMessageCommon(co CommonOperations, chatId int64, userId int64, messageId int64) (*Message, error) {
-	row := co.QueryRow(fmt.Sprintf(`SELECT m.id, m.text, m.owner_id, m.create_date_time, m.edit_date_time, m.file_item_uuid FROM message_chat_%v m WHERE m.id = $1 AND $3 in (SELECT chat_id FROM chat_participant WHERE user_id = $2 AND chat_id = $3)`, chatId), messageId, userId, chatId)
+	row := co.QueryRow(fmt.Sprintf(`%s WHERE m.id = $1 AND $3 in (SELECT chat_id FROM chat_participant JOIN chat ON chat.id = chat_id WHERE user_id = $2 AND chat_id = $3 AND chat.delete_date_time IS NULL)`, selectMessageClause(chatId)), messageId, userId, chatId)
 	message := Message{ChatId: chatId}
-	err := row.Scan(&message.Id, &message.Text, &message.OwnerId, &message.CreateDateTime, &message.EditDateTime, &message.FileItemUuid)
+	err := row.Scan(provideScanToMessage(&message)...)
 	if errors.Is(err, sql.ErrNoRows) {
 		// there were no rows, but otherwise no error occurred
 		return nil, nil
@@ -109,19 +195,93 @@ func (tx *Tx) GetMessage(chatId int64, userId int64, messageId int64) (*Message,
 	return getMessageCommon(tx, chatId, userId, messageId)
 }
 
-func addMessageReadCommon(co CommonOperations, messageId, userId int64, chatId int64) error {
-	_, err := co.Exec(`INSERT INTO message_read (last_message_id, user_id, chat_id) VALUES ($1, $2, $3) ON CONFLICT (user_id, chat_id) DO UPDATE SET last_message_id = $1  WHERE $1 > (SELECT MAX(last_message_id) FROM message_read WHERE user_id = $2 AND chat_id = $3)`, messageId, userId, chatId)
-	return err
+// returns true if the last read message of the user was moved forward.
+// read_count is taken from the chat's counter minus the newer messages, which are usually few
+func addMessageReadCommon(co CommonOperations, messageId, userId int64, chatId int64) (bool, error) {
+	res, err := co.Exec(fmt.Sprintf(`INSERT INTO message_read (last_message_id, user_id, chat_id, read_count) VALUES ($1, $2, $3, (SELECT message_count FROM chat WHERE id = $3) - (SELECT COUNT(*) FROM message_chat_%v WHERE id > $1)) ON CONFLICT (user_id, chat_id) DO UPDATE SET last_message_id = $1, read_count = excluded.read_count WHERE $1 > (SELECT MAX(last_message_id) FROM message_read WHERE user_id = $2 AND chat_id = $3)`, chatId), messageId, userId, chatId)
+	if err != nil {
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		Logger.Errorf("Error during checking rows affected %v", err)
+		return false, err
+	}
+	return affected > 0, nil
 }
 
-func (db *DB) AddMessageRead(messageId, userId int64, chatId int64) error {
+func (db *DB) AddMessageRead(messageId, userId int64, chatId int64) (bool, error) {
 	return addMessageReadCommon(db, messageId, userId, chatId)
 }
 
-func (tx *Tx) AddMessageRead(messageId, userId int64, chatId int64) error {
+func (tx *Tx) AddMessageRead(messageId, userId int64, chatId int64) (bool, error) {
 	return addMessageReadCommon(tx, messageId, userId, chatId)
 }
 
+// message_read keeps only the last read message, so the message is read by everyone whose last read message isn't older.
+// The owner isn't counted as a reader of the own message.
+func getReadByCountsCommon(co CommonOperations, chatId int64, messageIds []int64) (map[int64]int64, error) {
+	res := map[int64]int64{}
+	if len(messageIds) == 0 {
+		return res, nil
+	}
+	rows, err := co.Query(fmt.Sprintf(`
+		SELECT m.id, (
+			SELECT count(*) FROM message_read mr
+			WHERE mr.chat_id = $1 AND mr.last_message_id >= m.id AND mr.user_id <> m.owner_id
+			AND mr.user_id IN (SELECT user_id FROM chat_participant WHERE chat_id = $1)
+		)
+		FROM message_chat_%v m WHERE m.id = ANY($2)`, chatId), chatId, messageIds)
+	if err != nil {
+		Logger.Errorf("Error during get read by counts %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var messageId, count int64
+		if err := rows.Scan(&messageId, &count); err != nil {
+			Logger.Errorf("Error during scan read by count rows %v", err)
+			return nil, err
+		} else {
+			res[messageId] = count
+		}
+	}
+	return res, nil
+}
+
+func (db *DB) GetReadByCounts(chatId int64, messageIds []int64) (map[int64]int64, error) {
+	return getReadByCountsCommon(db, chatId, messageIds)
+}
+
+func (tx *Tx) GetReadByCounts(chatId int64, messageIds []int64) (map[int64]int64, error) {
+	return getReadByCountsCommon(tx, chatId, messageIds)
+}
+
+func (db *DB) GetMessageReaders(chatId int64, message *Message, limit, offset int) ([]int64, error) {
+	rows, err := db.Query(`
+		SELECT mr.user_id FROM message_read mr
+		WHERE mr.chat_id = $1 AND mr.last_message_id >= $2 AND mr.user_id <> $3
+		AND mr.user_id IN (SELECT user_id FROM chat_participant WHERE chat_id = $1)
+		ORDER BY mr.user_id LIMIT $4 OFFSET $5`,
+		chatId, message.Id, message.OwnerId, limit, offset)
+	if err != nil {
+		Logger.Errorf("Error during get message readers %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+	list := make([]int64, 0)
+	for rows.Next() {
+		var userId int64
+		if err := rows.Scan(&userId); err != nil {
+			Logger.Errorf("Error during scan message readers rows %v", err)
+			return nil, err
+		} else {
+			list = append(list, userId)
+		}
+	}
+	return list, nil
+}
+
 func (tx *Tx) EditMessage(m *Message) error {
 	if m == nil {
 		return errors.New("message required")
@@ -131,6 +291,12 @@ func (tx *Tx) EditMessage(m *Message) error {
 		return errors.New("id required")
 	}
 
+	// keep the previous version
+	if _, err := tx.Exec(fmt.Sprintf(`INSERT INTO message_history (chat_id, message_id, owner_id, text, file_item_uuid, version_date_time, action) SELECT $1, id, owner_id, text, file_item_uuid, COALESCE(edit_date_time, create_date_time), $2 FROM message_chat_%v WHERE owner_id = $3 AND id = $4`, m.ChatId), m.ChatId, MessageHistoryActionEdited, m.OwnerId, m.Id); err != nil {
+		Logger.Errorf("Error during saving message history %v", err)
+		return err
+	}
+
 	if res, err := tx.Exec(fmt.Sprintf(`UPDATE message_chat_%v SET text = $1, edit_date_time = utc_now(), file_item_uuid = $2 WHERE owner_id = $3 AND id = $4`, m.ChatId), m.Text, m.FileItemUuid, m.OwnerId, m.Id); err != nil {
 		Logger.Errorf("Error during editing message id %v", err)
 		return err
@@ -147,8 +313,52 @@ func (tx *Tx) EditMessage(m *Message) error {
 	return nil
 }
 
-func (db *DB) DeleteMessage(messageId int64, ownerId int64, chatId int64) error {
-	if res, err := db.Exec(fmt.Sprintf(`DELETE FROM message_chat_%v WHERE id = $1 AND owner_id = $2`, chatId), messageId, ownerId); err != nil {
+func (tx *Tx) PinMessage(chatId, messageId int64, pin bool) error {
+	if res, err := tx.Exec(fmt.Sprintf(`UPDATE message_chat_%v SET pinned = $1 WHERE id = $2`, chatId), pin, messageId); err != nil {
+		Logger.Errorf("Error during pinning message id %v", err)
+		return err
+	} else {
+		affected, err := res.RowsAffected()
+		if err != nil {
+			Logger.Errorf("Error during checking rows affected %v", err)
+			return err
+		}
+		if affected == 0 {
+			return errors.New("No rows affected")
+		}
+	}
+	return nil
+}
+
+func (db *DB) GetPinnedMessages(chatId int64, userId int64) ([]*Message, error) {
+	rows, err := db.Query(fmt.Sprintf(`%s WHERE $2 IN ( SELECT chat_id FROM chat_participant JOIN chat ON chat.id = chat_id WHERE user_id = $1 AND chat_id = $2 AND chat.delete_date_time IS NULL ) AND m.pinned = TRUE ORDER BY m.id DESC`, selectMessageClause(chatId)), userId, chatId)
+	if err != nil {
+		Logger.Errorf("Error during get pinned message rows %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+	list := make([]*Message, 0)
+	for rows.Next() {
+		message := Message{ChatId: chatId}
+		if err := rows.Scan(provideScanToMessage(&message)...); err != nil {
+			Logger.Errorf("Error during scan message rows %v", err)
+			return nil, err
+		} else {
+			list = append(list, &message)
+		}
+	}
+	return list, nil
+}
+
+// DeleteMessage moves the message to the history as a tombstone
+func deleteMessageCommon(co CommonOperations, messageId int64, ownerId int64, chatId int64) error {
+	if res, err := co.Exec(fmt.Sprintf(`
+		WITH deleted AS (
+			DELETE FROM message_chat_%v WHERE id = $1 AND owner_id = $2 RETURNING id, owner_id, text, file_item_uuid, create_date_time, edit_date_time
+		)
+		INSERT INTO message_history (chat_id, message_id, owner_id, text, file_item_uuid, version_date_time, action)
+		SELECT $3, id, owner_id, text, file_item_uuid, COALESCE(edit_date_time, create_date_time), $4 FROM deleted`, chatId),
+		messageId, ownerId, chatId, MessageHistoryActionDeleted); err != nil {
 		Logger.Errorf("Error during deleting message id %v", err)
 		return err
 	} else {
@@ -164,6 +374,14 @@ func (db *DB) DeleteMessage(messageId int64, ownerId int64, chatId int64) error
 	return nil
 }
 
+func (db *DB) DeleteMessage(messageId int64, ownerId int64, chatId int64) error {
+	return deleteMessageCommon(db, messageId, ownerId, chatId)
+}
+
+func (tx *Tx) DeleteMessage(messageId int64, ownerId int64, chatId int64) error {
+	return deleteMessageCommon(tx, messageId, ownerId, chatId)
+}
+
 func (dbR *DB) SetFileItemUuidToNull(ownerId, chatId int64, uuid string) (int64, error) {
 	res := dbR.QueryRow(fmt.Sprintf(`UPDATE message_chat_%v SET file_item_uuid = NULL WHERE file_item_uuid = $1 AND owner_id = $2 RETURNING id`, chatId), uuid, ownerId)
 
@@ -180,48 +398,61 @@ func (dbR *DB) SetFileItemUuidToNull(ownerId, chatId int64, uuid string) (int64,
 	}
 }
 
+// the counters are kept by the triggers of the messages' tables, so the count doesn't touch them
+func getUnreadMessagesCountsCommon(co CommonOperations, chatIds []int64, userId int64) (map[int64]int64, error) {
+	res := map[int64]int64{}
+	if len(chatIds) == 0 {
+		return res, nil
+	}
+	rows, err := co.Query(`SELECT c.id, c.message_count - COALESCE(mr.read_count, 0) FROM chat c LEFT JOIN message_read mr ON mr.chat_id = c.id AND mr.user_id = $1 WHERE c.id = ANY($2)`, userId, chatIds)
+	if err != nil {
+		Logger.Errorf("Error during get unread messages counts %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var chatId, count int64
+		if err := rows.Scan(&chatId, &count); err != nil {
+			Logger.Errorf("Error during scan unread messages counts %v", err)
+			return nil, err
+		} else {
+			res[chatId] = count
+		}
+	}
+	return res, nil
+}
+
 func getUnreadMessagesCountCommon(co CommonOperations, chatId int64, userId int64) (int64, error) {
-	var count int64
-	row := co.QueryRow("SELECT * FROM UNREAD_MESSAGES($1, $2)", chatId, userId)
-	err := row.Scan(&count)
+	counts, err := getUnreadMessagesCountsCommon(co, []int64{chatId}, userId)
 	if err != nil {
 		return 0, err
-	} else {
-		return count, nil
 	}
+	return counts[chatId], nil
 }
 
 func getAllUnreadMessagesCountCommon(co CommonOperations, userId int64) (int64, error) {
 	var count int64
-
-	if rows, err := co.Query("SELECT chat_id FROM chat_participant WHERE user_id = $1", userId); err != nil {
-		Logger.Errorf("Error during get chat rows %v", err)
+	// the muted chats don't make the badge
+	row := co.QueryRow(`
+		SELECT COALESCE(SUM(c.message_count - COALESCE(mr.read_count, 0)), 0)
+		FROM chat_participant cp
+		JOIN chat c ON c.id = cp.chat_id
+		LEFT JOIN message_read mr ON mr.chat_id = cp.chat_id AND mr.user_id = cp.user_id
+		WHERE cp.user_id = $1 AND cp.muted = false AND c.delete_date_time IS NULL`, userId)
+	if err := row.Scan(&count); err != nil {
+		Logger.Errorf("Error during get all unread messages count %v", err)
 		return 0, err
-	} else {
-		defer rows.Close()
-		list := make([]int64, 0)
-		for rows.Next() {
-			var chatId int64
-			if err := rows.Scan(&chatId); err != nil {
-				Logger.Errorf("Error during scan participant rows %v", err)
-				return 0, err
-			} else {
-				list = append(list, chatId)
-			}
-		}
-
-		for _, chatId := range list {
-			perChatCount, err := getUnreadMessagesCountCommon(co, chatId, userId)
-			if err != nil {
-				Logger.Errorf("Error during get per chat counts %v", err)
-				return 0, err
-			}
-			count += perChatCount
-		}
-
-		return count, nil
 	}
+	return count, nil
+}
+
+// GetUnreadMessagesCounts returns the unread messages count for each of the given chats with the one query
+func (db *DB) GetUnreadMessagesCounts(chatIds []int64, userId int64) (map[int64]int64, error) {
+	return getUnreadMessagesCountsCommon(db, chatIds, userId)
+}
 
+func (tx *Tx) GetUnreadMessagesCounts(chatIds []int64, userId int64) (map[int64]int64, error) {
+	return getUnreadMessagesCountsCommon(tx, chatIds, userId)
 }
 
 func (db *DB) GetUnreadMessagesCount(chatId int64, userId int64) (int64, error) {
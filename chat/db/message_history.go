@@ -0,0 +1,72 @@
+package db
+
+import (
+	"github.com/google/uuid"
+	. "nkonev.name/chat/logger"
+	"time"
+)
+
+const (
+	MessageHistoryActionEdited  = "edited"
+	MessageHistoryActionDeleted = "deleted"
+)
+
+// db model
+
+// MessageVersion is a message's state before it was edited or deleted
+type MessageVersion struct {
+	Id              int64
+	ChatId          int64
+	MessageId       int64
+	OwnerId         int64
+	Text            string
+	FileItemUuid    *uuid.UUID
+	VersionDateTime time.Time
+	Action          string
+	ActionDateTime  time.Time
+}
+
+const selectMessageVersionClause = `SELECT id, chat_id, message_id, owner_id, text, file_item_uuid, version_date_time, action, action_date_time FROM message_history `
+
+func provideScanToMessageVersion(version *MessageVersion) []interface{} {
+	return []interface{}{
+		&version.Id,
+		&version.ChatId,
+		&version.MessageId,
+		&version.OwnerId,
+		&version.Text,
+		&version.FileItemUuid,
+		&version.VersionDateTime,
+		&version.Action,
+		&version.ActionDateTime,
+	}
+}
+
+func (db *DB) getMessageVersions(query string, args ...interface{}) ([]*MessageVersion, error) {
+	rows, err := db.Query(selectMessageVersionClause+query, args...)
+	if err != nil {
+		Logger.Errorf("Error during get message history rows %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+	list := make([]*MessageVersion, 0)
+	for rows.Next() {
+		version := MessageVersion{}
+		if err := rows.Scan(provideScanToMessageVersion(&version)...); err != nil {
+			Logger.Errorf("Error during scan message history rows %v", err)
+			return nil, err
+		} else {
+			list = append(list, &version)
+		}
+	}
+	return list, nil
+}
+
+// GetMessageHistory returns the previous versions of the message from the oldest, the tombstone of the deleted message is the last one
+func (db *DB) GetMessageHistory(chatId, messageId int64) ([]*MessageVersion, error) {
+	return db.getMessageVersions(`WHERE chat_id = $1 AND message_id = $2 ORDER BY id ASC`, chatId, messageId)
+}
+
+func (db *DB) GetDeletedMessages(chatId int64, limit, offset int) ([]*MessageVersion, error) {
+	return db.getMessageVersions(`WHERE chat_id = $1 AND action = $2 ORDER BY message_id DESC LIMIT $3 OFFSET $4`, chatId, MessageHistoryActionDeleted, limit, offset)
+}
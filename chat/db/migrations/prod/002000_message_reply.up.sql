@@ -0,0 +1 @@
+ALTER TABLE message ADD COLUMN reply_to_message_id BIGINT;
@@ -0,0 +1,8 @@
+CREATE TABLE message_reaction (
+    chat_id BIGINT NOT NULL REFERENCES chat(id) ON DELETE CASCADE,
+    message_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    reaction VARCHAR(32) NOT NULL,
+    create_date_time TIMESTAMP NOT NULL DEFAULT utc_now(),
+    PRIMARY KEY (chat_id, message_id, user_id)
+);
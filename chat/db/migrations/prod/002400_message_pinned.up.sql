@@ -0,0 +1 @@
+ALTER TABLE message ADD COLUMN pinned BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,42 @@
+-- generated columns require immutable expressions
+CREATE OR REPLACE FUNCTION strip_tags(TEXT) RETURNS TEXT AS $$
+SELECT regexp_replace($1, '<[^>]*>', '', 'g')
+$$ LANGUAGE SQL IMMUTABLE;
+
+-- contains lexemes of both the supported UI languages, so a query in any of them matches
+ALTER TABLE message ADD COLUMN text_search tsvector GENERATED ALWAYS AS (to_tsvector('english', strip_tags(text)) || to_tsvector('russian', strip_tags(text))) STORED;
+
+CREATE INDEX message_text_search_idx ON message USING GIN (text_search);
+
+-- indexes aren't inherited, so we need to create them on the each existing chat's table
+DO
+$do$
+    DECLARE
+        chat_id BIGINT;
+    BEGIN
+        FOR chat_id IN SELECT id FROM chat LOOP
+            EXECUTE format('CREATE INDEX ON %s USING GIN (text_search);', 'message_chat_' || chat_id);
+        END LOOP;
+    END
+$do$;
+
+DROP FUNCTION CREATE_CHAT;
+
+CREATE OR REPLACE FUNCTION CREATE_CHAT(IN chat_name TEXT, IN tet_a_tet BOOLEAN DEFAULT false) RETURNS RECORD AS $$
+DECLARE
+    chat_id BIGINT;
+    chat_last_update_date_time TIMESTAMP;
+    query1 text;
+    ret RECORD;
+BEGIN
+    INSERT INTO chat(title, tet_a_tet) VALUES(chat_name, tet_a_tet) RETURNING id, last_update_date_time INTO chat_id, chat_last_update_date_time;
+    query1 := format('CREATE TABLE %s() INHERITS (message)', 'message_chat_' || chat_id);
+    EXECUTE query1;
+    query1 := format('ALTER TABLE %s ADD PRIMARY KEY(id);', 'message_chat_' || chat_id);
+    EXECUTE query1;
+    query1 := format('CREATE INDEX ON %s USING GIN (text_search);', 'message_chat_' || chat_id);
+    EXECUTE query1;
+    SELECT chat_id, chat_last_update_date_time INTO ret;
+    RETURN ret;
+END
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,13 @@
+CREATE TABLE scheduled_message (
+    id BIGSERIAL PRIMARY KEY,
+    chat_id BIGINT NOT NULL REFERENCES chat(id) ON DELETE CASCADE,
+    owner_id BIGINT NOT NULL,
+    text TEXT NOT NULL,
+    file_item_uuid UUID,
+    reply_to_message_id BIGINT,
+    send_at TIMESTAMP NOT NULL,
+    create_date_time TIMESTAMP NOT NULL DEFAULT utc_now()
+);
+
+CREATE INDEX scheduled_message_send_at_idx ON scheduled_message(send_at);
+CREATE INDEX scheduled_message_chat_owner_idx ON scheduled_message(chat_id, owner_id);
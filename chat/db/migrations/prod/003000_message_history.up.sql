@@ -0,0 +1,15 @@
+-- previous versions of edited messages and tombstones of deleted ones
+CREATE TABLE message_history (
+    id BIGSERIAL PRIMARY KEY,
+    chat_id BIGINT NOT NULL REFERENCES chat(id) ON DELETE CASCADE,
+    message_id BIGINT NOT NULL,
+    owner_id BIGINT NOT NULL,
+    text TEXT NOT NULL,
+    file_item_uuid UUID,
+    -- when this version appeared, e. g. create or previous edit time
+    version_date_time TIMESTAMP NOT NULL,
+    action VARCHAR(16) NOT NULL,
+    action_date_time TIMESTAMP NOT NULL DEFAULT utc_now()
+);
+
+CREATE INDEX message_history_chat_message_idx ON message_history(chat_id, message_id);
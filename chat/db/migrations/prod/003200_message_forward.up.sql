@@ -0,0 +1,4 @@
+-- the original message of the forwarded one
+ALTER TABLE message ADD COLUMN forwarded_from_chat_id BIGINT;
+ALTER TABLE message ADD COLUMN forwarded_from_message_id BIGINT;
+ALTER TABLE message ADD COLUMN forwarded_from_owner_id BIGINT;
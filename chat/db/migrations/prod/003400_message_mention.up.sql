@@ -0,0 +1,9 @@
+CREATE TABLE message_mention (
+    chat_id BIGINT NOT NULL REFERENCES chat(id) ON DELETE CASCADE,
+    message_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    create_date_time TIMESTAMP NOT NULL DEFAULT utc_now(),
+    PRIMARY KEY (chat_id, message_id, user_id)
+);
+
+CREATE INDEX message_mention_user_idx ON message_mention(user_id);
@@ -0,0 +1,3 @@
+-- the participant's own settings of the chat
+ALTER TABLE chat_participant ADD COLUMN muted BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE chat_participant ADD COLUMN archived BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1,2 @@
+-- the participant's pinned chats are at the top of the list
+ALTER TABLE chat_participant ADD COLUMN pinned BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1,16 @@
+-- private chats are joined only by admin's adding, public ones are listed in the directory, the link ones are joined by invite
+ALTER TABLE chat ADD COLUMN visibility VARCHAR(16) NOT NULL DEFAULT 'private';
+
+CREATE INDEX chat_public_idx ON chat(last_update_date_time, id) WHERE visibility = 'public';
+
+CREATE TABLE chat_invite (
+    token VARCHAR(64) PRIMARY KEY,
+    chat_id BIGINT NOT NULL REFERENCES chat(id) ON DELETE CASCADE,
+    creator_id BIGINT NOT NULL,
+    create_date_time TIMESTAMP NOT NULL DEFAULT utc_now(),
+    expire_date_time TIMESTAMP,
+    max_uses INT,
+    uses INT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX chat_invite_chat_idx ON chat_invite(chat_id);
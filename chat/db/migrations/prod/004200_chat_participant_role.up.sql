@@ -0,0 +1,19 @@
+-- the role defines the participant's permissions, the admin column is kept as "the role is owner or admin"
+ALTER TABLE chat_participant ADD COLUMN role VARCHAR(16);
+UPDATE chat_participant SET role = CASE WHEN admin THEN 'admin' ELSE 'member' END;
+-- the creators of the existing chats aren't known, so the admin with the least id becomes the owner, both tet-a-tet participants are the owners
+UPDATE chat_participant cp SET role = 'owner' WHERE admin AND ((SELECT tet_a_tet FROM chat WHERE id = cp.chat_id) OR user_id = (SELECT min(user_id) FROM chat_participant a WHERE a.chat_id = cp.chat_id AND a.admin));
+ALTER TABLE chat_participant ALTER COLUMN role SET NOT NULL;
+
+-- the inserts without role take it from admin, the admin is always derived from the role
+CREATE OR REPLACE FUNCTION CHAT_PARTICIPANT_ROLE_SYNC() RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.role IS NULL THEN
+        NEW.role := CASE WHEN NEW.admin THEN 'admin' ELSE 'member' END;
+    END IF;
+    NEW.admin := NEW.role IN ('owner', 'admin');
+    RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER chat_participant_role_sync BEFORE INSERT OR UPDATE ON chat_participant FOR EACH ROW EXECUTE FUNCTION CHAT_PARTICIPANT_ROLE_SYNC();
@@ -0,0 +1,2 @@
+-- only the admins write to the channel, the rest participants read
+ALTER TABLE chat ADD COLUMN channel BOOLEAN NOT NULL DEFAULT false;
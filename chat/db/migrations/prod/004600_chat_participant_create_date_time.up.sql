@@ -0,0 +1,4 @@
+-- the time of joining defines the successor of the owner, the existing participants are considered joined at once
+ALTER TABLE chat_participant ADD COLUMN create_date_time TIMESTAMP NOT NULL DEFAULT utc_now();
+-- the chats left by all the admins get the owner
+UPDATE chat_participant cp SET role = 'owner' WHERE user_id = (SELECT min(user_id) FROM chat_participant a WHERE a.chat_id = cp.chat_id) AND NOT EXISTS (SELECT 1 FROM chat_participant o WHERE o.chat_id = cp.chat_id AND o.admin);
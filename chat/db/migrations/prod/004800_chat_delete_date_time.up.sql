@@ -0,0 +1,3 @@
+-- the deleted chat is kept until the retention period ends, so it can be restored
+ALTER TABLE chat ADD COLUMN delete_date_time TIMESTAMP;
+CREATE INDEX chat_delete_date_time_idx ON chat(delete_date_time) WHERE delete_date_time IS NOT NULL;
@@ -0,0 +1,24 @@
+-- the outgoing webhooks, the empty event_types means all the events
+CREATE TABLE chat_webhook (
+    id BIGSERIAL PRIMARY KEY,
+    chat_id BIGINT NOT NULL REFERENCES chat(id) ON DELETE CASCADE,
+    url TEXT NOT NULL,
+    secret TEXT NOT NULL,
+    event_types TEXT[] NOT NULL DEFAULT '{}',
+    creator_id BIGINT NOT NULL,
+    create_date_time TIMESTAMP NOT NULL DEFAULT utc_now()
+);
+CREATE INDEX chat_webhook_chat_id_idx ON chat_webhook(chat_id);
+
+-- every attempt of delivery, status_code is null if the request has failed before the response
+CREATE TABLE chat_webhook_delivery (
+    id BIGSERIAL PRIMARY KEY,
+    webhook_id BIGINT NOT NULL REFERENCES chat_webhook(id) ON DELETE CASCADE,
+    event_type VARCHAR(64) NOT NULL,
+    attempt INT NOT NULL,
+    status_code INT,
+    error TEXT,
+    success BOOLEAN NOT NULL,
+    create_date_time TIMESTAMP NOT NULL DEFAULT utc_now()
+);
+CREATE INDEX chat_webhook_delivery_webhook_id_idx ON chat_webhook_delivery(webhook_id, id);
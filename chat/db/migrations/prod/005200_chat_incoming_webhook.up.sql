@@ -0,0 +1,18 @@
+-- the bots write the messages as the owner with the negative id, so they never clash with the users of aaa
+CREATE TABLE chat_bot (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(256) NOT NULL,
+    avatar VARCHAR(1024),
+    creator_id BIGINT NOT NULL,
+    create_date_time TIMESTAMP NOT NULL DEFAULT utc_now()
+);
+
+-- the incoming webhooks, the bot survives the webhook in order to keep the owner of its messages
+CREATE TABLE chat_incoming_webhook (
+    token VARCHAR(64) PRIMARY KEY,
+    chat_id BIGINT NOT NULL REFERENCES chat(id) ON DELETE CASCADE,
+    bot_id BIGINT NOT NULL REFERENCES chat_bot(id),
+    creator_id BIGINT NOT NULL,
+    create_date_time TIMESTAMP NOT NULL DEFAULT utc_now()
+);
+CREATE INDEX chat_incoming_webhook_chat_id_idx ON chat_incoming_webhook(chat_id);
@@ -0,0 +1,12 @@
+-- the bots with the api token are the participants, the webhook ones have no token
+ALTER TABLE chat_bot ADD COLUMN token_hash VARCHAR(64) UNIQUE;
+
+-- the events addressed to the bots, they are taken with the long polling
+CREATE TABLE chat_bot_event (
+    id BIGSERIAL PRIMARY KEY,
+    bot_id BIGINT NOT NULL REFERENCES chat_bot(id) ON DELETE CASCADE,
+    event_type VARCHAR(64) NOT NULL,
+    payload TEXT NOT NULL,
+    create_date_time TIMESTAMP NOT NULL DEFAULT utc_now()
+);
+CREATE INDEX chat_bot_event_bot_id_idx ON chat_bot_event(bot_id, id);
@@ -0,0 +1,10 @@
+-- the cache of the link previews shared by all the chats, the failed fetches are cached too to not repeat them
+CREATE TABLE chat_link_preview (
+    url VARCHAR(2048) PRIMARY KEY,
+    success BOOLEAN NOT NULL,
+    title VARCHAR(512),
+    description VARCHAR(1024),
+    image_url VARCHAR(2048),
+    site_name VARCHAR(256),
+    fetch_date_time TIMESTAMP NOT NULL DEFAULT utc_now()
+);
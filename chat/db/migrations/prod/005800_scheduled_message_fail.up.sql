@@ -0,0 +1,4 @@
+-- the message which has failed to be sent isn't taken again, so it doesn't block the queue
+ALTER TABLE scheduled_message ADD COLUMN fail_date_time TIMESTAMP;
+DROP INDEX scheduled_message_send_at_idx;
+CREATE INDEX scheduled_message_send_at_idx ON scheduled_message(send_at) WHERE fail_date_time IS NULL;
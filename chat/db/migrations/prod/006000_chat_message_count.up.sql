@@ -0,0 +1,57 @@
+-- the counters let the unread messages of many chats be taken with one query instead of counting in every chat's table
+ALTER TABLE chat ADD COLUMN message_count BIGINT NOT NULL DEFAULT 0;
+-- the count of the chat's messages up to the last read one
+ALTER TABLE message_read ADD COLUMN read_count BIGINT NOT NULL DEFAULT 0;
+
+-- the chat's id is the trigger's argument, the messages don't carry it
+CREATE OR REPLACE FUNCTION COUNT_CHAT_MESSAGES() RETURNS TRIGGER AS $$
+DECLARE
+    counted_chat_id BIGINT := TG_ARGV[0]::BIGINT;
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        UPDATE chat SET message_count = message_count + 1 WHERE id = counted_chat_id;
+        RETURN NEW;
+    ELSE
+        UPDATE chat SET message_count = message_count - 1 WHERE id = counted_chat_id;
+        UPDATE message_read SET read_count = read_count - 1 WHERE chat_id = counted_chat_id AND last_message_id >= OLD.id;
+        RETURN OLD;
+    END IF;
+END
+$$ LANGUAGE plpgsql;
+
+-- triggers aren't inherited, so we need to create them on the each existing chat's table
+DO
+$do$
+    DECLARE
+        chat_id BIGINT;
+    BEGIN
+        FOR chat_id IN SELECT id FROM chat WHERE to_regclass('message_chat_' || id) IS NOT NULL LOOP
+            EXECUTE format('UPDATE chat SET message_count = (SELECT COUNT(*) FROM %s) WHERE id = %s;', 'message_chat_' || chat_id, chat_id);
+            EXECUTE format('UPDATE message_read mr SET read_count = (SELECT COUNT(*) FROM %s m WHERE m.id <= mr.last_message_id) WHERE mr.chat_id = %s;', 'message_chat_' || chat_id, chat_id);
+            EXECUTE format('CREATE TRIGGER message_count_trigger AFTER INSERT OR DELETE ON %s FOR EACH ROW EXECUTE FUNCTION COUNT_CHAT_MESSAGES(%s);', 'message_chat_' || chat_id, chat_id);
+        END LOOP;
+    END
+$do$;
+
+DROP FUNCTION CREATE_CHAT;
+
+CREATE OR REPLACE FUNCTION CREATE_CHAT(IN chat_name TEXT, IN tet_a_tet BOOLEAN DEFAULT false) RETURNS RECORD AS $$
+DECLARE
+    chat_id BIGINT;
+    chat_last_update_date_time TIMESTAMP;
+    query1 text;
+    ret RECORD;
+BEGIN
+    INSERT INTO chat(title, tet_a_tet) VALUES(chat_name, tet_a_tet) RETURNING id, last_update_date_time INTO chat_id, chat_last_update_date_time;
+    query1 := format('CREATE TABLE %s() INHERITS (message)', 'message_chat_' || chat_id);
+    EXECUTE query1;
+    query1 := format('ALTER TABLE %s ADD PRIMARY KEY(id);', 'message_chat_' || chat_id);
+    EXECUTE query1;
+    query1 := format('CREATE INDEX ON %s USING GIN (text_search);', 'message_chat_' || chat_id);
+    EXECUTE query1;
+    query1 := format('CREATE TRIGGER message_count_trigger AFTER INSERT OR DELETE ON %s FOR EACH ROW EXECUTE FUNCTION COUNT_CHAT_MESSAGES(%s);', 'message_chat_' || chat_id, chat_id);
+    EXECUTE query1;
+    SELECT chat_id, chat_last_update_date_time INTO ret;
+    RETURN ret;
+END
+$$ LANGUAGE plpgsql;
@@ -1,6 +1,8 @@
 package db
 
 import (
+	"database/sql"
+	"errors"
 	. "nkonev.name/chat/logger"
 )
 
@@ -11,8 +13,8 @@ type ChatParticipant struct {
 	UserId int64
 }
 
-func (tx *Tx) AddParticipant(userId int64, chatId int64, admin bool) error {
-	_, err := tx.Exec(`INSERT INTO chat_participant (chat_id, user_id, admin) VALUES ($1, $2, $3)`, chatId, userId, admin)
+func (tx *Tx) AddParticipant(userId int64, chatId int64, role string) error {
+	_, err := tx.Exec(`INSERT INTO chat_participant (chat_id, user_id, role) VALUES ($1, $2, $3)`, chatId, userId, role)
 	return err
 }
 
@@ -48,6 +50,37 @@ func (db *DB) GetParticipantIds(chatId int64, participantsSize, participantsOffs
 	return getParticipantIdsCommon(db, chatId, participantsSize, participantsOffset)
 }
 
+// getParticipantIdsBatchCommon returns the page of participants for each of the given chats with the one query
+func getParticipantIdsBatchCommon(qq CommonOperations, chatIds []int64, participantsSize, participantsOffset int) (map[int64][]int64, error) {
+	res := map[int64][]int64{}
+	for _, chatId := range chatIds {
+		res[chatId] = make([]int64, 0)
+	}
+	if len(chatIds) == 0 {
+		return res, nil
+	}
+	if rows, err := qq.Query(`
+		SELECT chat_id, user_id FROM (
+			SELECT chat_id, user_id, row_number() OVER (PARTITION BY chat_id ORDER BY user_id) AS rn FROM chat_participant WHERE chat_id = ANY($1)
+		) p
+		WHERE rn > $3 AND rn <= $3 + $2
+		ORDER BY chat_id, user_id`, chatIds, participantsSize, participantsOffset); err != nil {
+		return nil, err
+	} else {
+		defer rows.Close()
+		for rows.Next() {
+			var chatId, participantId int64
+			if err := rows.Scan(&chatId, &participantId); err != nil {
+				Logger.Errorf("Error during scan chat rows %v", err)
+				return nil, err
+			} else {
+				res[chatId] = append(res[chatId], participantId)
+			}
+		}
+		return res, nil
+	}
+}
+
 func getAllParticipantIdsCommon(qq CommonOperations, chatId int64) ([]int64, error) {
 	if rows, err := qq.Query("SELECT user_id FROM chat_participant WHERE chat_id = $1 ORDER BY user_id", chatId); err != nil {
 		return nil, err
@@ -94,9 +127,32 @@ func (db *DB) GetParticipantsCount(chatId int64) (int, error) {
 	return getParticipantsCountCommon(db, chatId)
 }
 
+func getParticipantsCountBatchCommon(qq CommonOperations, chatIds []int64) (map[int64]int, error) {
+	res := map[int64]int{}
+	if len(chatIds) == 0 {
+		return res, nil
+	}
+	if rows, err := qq.Query("SELECT chat_id, count(*) FROM chat_participant WHERE chat_id = ANY($1) GROUP BY chat_id", chatIds); err != nil {
+		return nil, err
+	} else {
+		defer rows.Close()
+		for rows.Next() {
+			var chatId int64
+			var count int
+			if err := rows.Scan(&chatId, &count); err != nil {
+				Logger.Errorf("Error during scan participants count rows %v", err)
+				return nil, err
+			} else {
+				res[chatId] = count
+			}
+		}
+		return res, nil
+	}
+}
+
 func getIsAdminCommon(qq CommonOperations, userId int64, chatId int64) (bool, error) {
 	var admin bool = false
-	row := qq.QueryRow(`SELECT exists(SELECT * FROM chat_participant WHERE user_id = $1 AND chat_id = $2 AND admin = true LIMIT 1)`, userId, chatId)
+	row := qq.QueryRow(`SELECT exists(SELECT * FROM chat_participant cp JOIN chat c ON c.id = cp.chat_id WHERE cp.user_id = $1 AND cp.chat_id = $2 AND cp.admin = true AND c.delete_date_time IS NULL LIMIT 1)`, userId, chatId)
 	if err := row.Scan(&admin); err != nil {
 		return false, err
 	} else {
@@ -112,9 +168,56 @@ func (db *DB) IsAdmin(userId int64, chatId int64) (bool, error) {
 	return getIsAdminCommon(db, userId, chatId)
 }
 
+// getParticipantRoleCommon returns an empty string if the user isn't a participant
+func getParticipantRoleCommon(qq CommonOperations, userId int64, chatId int64) (string, error) {
+	var role string
+	row := qq.QueryRow(`SELECT cp.role FROM chat_participant cp JOIN chat c ON c.id = cp.chat_id WHERE cp.user_id = $1 AND cp.chat_id = $2 AND c.delete_date_time IS NULL`, userId, chatId)
+	err := row.Scan(&role)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		Logger.Errorf("Error during get participant role %v", err)
+		return "", err
+	}
+	return role, nil
+}
+
+func (tx *Tx) GetParticipantRole(userId int64, chatId int64) (string, error) {
+	return getParticipantRoleCommon(tx, userId, chatId)
+}
+
+func (db *DB) GetParticipantRole(userId int64, chatId int64) (string, error) {
+	return getParticipantRoleCommon(db, userId, chatId)
+}
+
+// getParticipantRolesBatchCommon returns the user's roles in the given chats
+func getParticipantRolesBatchCommon(qq CommonOperations, userId int64, chatIds []int64) (map[int64]string, error) {
+	res := map[int64]string{}
+	if len(chatIds) == 0 {
+		return res, nil
+	}
+	if rows, err := qq.Query(`SELECT chat_id, role FROM chat_participant WHERE user_id = $1 AND chat_id = ANY($2)`, userId, chatIds); err != nil {
+		return nil, err
+	} else {
+		defer rows.Close()
+		for rows.Next() {
+			var chatId int64
+			var role string
+			if err := rows.Scan(&chatId, &role); err != nil {
+				Logger.Errorf("Error during scan role rows %v", err)
+				return nil, err
+			} else {
+				res[chatId] = role
+			}
+		}
+		return res, nil
+	}
+}
+
 func isParticipantCommon(qq CommonOperations, userId int64, chatId int64) (bool, error) {
 	var exists bool = false
-	row := qq.QueryRow(`SELECT exists(SELECT * FROM chat_participant WHERE user_id = $1 AND chat_id = $2 LIMIT 1)`, userId, chatId)
+	row := qq.QueryRow(`SELECT exists(SELECT * FROM chat_participant cp JOIN chat c ON c.id = cp.chat_id WHERE cp.user_id = $1 AND cp.chat_id = $2 AND c.delete_date_time IS NULL LIMIT 1)`, userId, chatId)
 	if err := row.Scan(&exists); err != nil {
 		return false, err
 	} else {
@@ -130,9 +233,10 @@ func (db *DB) IsParticipant(userId int64, chatId int64) (bool, error) {
 	return isParticipantCommon(db, userId, chatId)
 }
 
+// GetFirstParticipant returns the longest-tenured participant
 func (tx *Tx) GetFirstParticipant(chatId int64) (int64, error) {
 	var pid int64
-	row := tx.QueryRow(`SELECT user_id FROM chat_participant WHERE chat_id = $1 LIMIT 1`, chatId)
+	row := tx.QueryRow(`SELECT user_id FROM chat_participant WHERE chat_id = $1 ORDER BY create_date_time, user_id LIMIT 1`, chatId)
 	if err := row.Scan(&pid); err != nil {
 		return 0, err
 	} else {
@@ -140,6 +244,46 @@ func (tx *Tx) GetFirstParticipant(chatId int64) (int64, error) {
 	}
 }
 
+// GetOwnerSuccessor is GetFirstParticipant which prefers the admins and skips the bots, it returns 0 if there are no users
+func (tx *Tx) GetOwnerSuccessor(chatId int64) (int64, error) {
+	var pid int64
+	row := tx.QueryRow(`SELECT user_id FROM chat_participant WHERE chat_id = $1 AND user_id > 0 ORDER BY admin DESC, create_date_time, user_id LIMIT 1`, chatId)
+	err := row.Scan(&pid)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		Logger.Errorf("Error during getting owner successor %v", err)
+		return 0, err
+	}
+	return pid, nil
+}
+
+// GetDeletedChatParticipantRole is GetParticipantRole for the soft-deleted chat, it returns an empty string if the chat isn't deleted
+func (tx *Tx) GetDeletedChatParticipantRole(userId int64, chatId int64) (string, error) {
+	var role string
+	row := tx.QueryRow(`SELECT cp.role FROM chat_participant cp JOIN chat c ON c.id = cp.chat_id WHERE cp.user_id = $1 AND cp.chat_id = $2 AND c.delete_date_time IS NOT NULL`, userId, chatId)
+	err := row.Scan(&role)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		Logger.Errorf("Error during get participant role %v", err)
+		return "", err
+	}
+	return role, nil
+}
+
+func (tx *Tx) CountParticipantsWithRoles(chatId int64, roles []string) (int, error) {
+	var count int
+	row := tx.QueryRow(`SELECT count(*) FROM chat_participant WHERE chat_id = $1 AND role = ANY($2)`, chatId, roles)
+	if err := row.Scan(&count); err != nil {
+		Logger.Errorf("Error during counting participants with roles %v", err)
+		return 0, err
+	}
+	return count, nil
+}
+
 func (db *DB) GetCoChattedParticipantIdsCommon(participantId int64) ([]int64, error) {
 	if rows, err := db.Query("SELECT DISTINCT user_id FROM chat_participant WHERE chat_id IN (SELECT chat_id FROM chat_participant WHERE user_id = $1) ORDER BY user_id", participantId); err != nil {
 		return nil, err
@@ -159,18 +303,42 @@ func (db *DB) GetCoChattedParticipantIdsCommon(participantId int64) ([]int64, er
 	}
 }
 
-func setAdminCommon(qq CommonOperations, userId int64, chatId int64, newAdmin bool) error {
-	if _, err := qq.Exec("UPDATE chat_participant SET admin = $3 WHERE user_id = $1 AND chat_id = $2", userId, chatId, newAdmin); err != nil {
-		Logger.Errorf("Error during editing participant admin id %v", err)
+func setParticipantRoleCommon(qq CommonOperations, userId int64, chatId int64, role string) error {
+	if _, err := qq.Exec("UPDATE chat_participant SET role = $3 WHERE user_id = $1 AND chat_id = $2", userId, chatId, role); err != nil {
+		Logger.Errorf("Error during editing participant role %v", err)
 		return err
 	}
 	return nil
 }
 
-func (tx *Tx) SetAdmin(userId int64, chatId int64, newAdmin bool) error {
-	return setAdminCommon(tx, userId, chatId, newAdmin)
+func (tx *Tx) SetParticipantRole(userId int64, chatId int64, role string) error {
+	return setParticipantRoleCommon(tx, userId, chatId, role)
+}
+
+func (db *DB) SetParticipantRole(userId int64, chatId int64, role string) error {
+	return setParticipantRoleCommon(db, userId, chatId, role)
 }
 
-func (db *DB) SetAdmin(userId int64, chatId int64, newAdmin bool) error {
-	return setAdminCommon(db, userId, chatId, newAdmin)
+// returns the chats which participantId shares with each of otherParticipantIds
+func (db *DB) GetCommonChatIds(participantId int64, otherParticipantIds []int64) (map[int64][]int64, error) {
+	res := map[int64][]int64{}
+	if len(otherParticipantIds) == 0 {
+		return res, nil
+	}
+	if rows, err := db.Query("SELECT other.user_id, other.chat_id FROM chat_participant me JOIN chat_participant other ON other.chat_id = me.chat_id WHERE me.user_id = $1 AND other.user_id = ANY($2) ORDER BY other.user_id, other.chat_id", participantId, otherParticipantIds); err != nil {
+		Logger.Errorf("Error during get common chats %v", err)
+		return nil, err
+	} else {
+		defer rows.Close()
+		for rows.Next() {
+			var otherParticipantId, chatId int64
+			if err := rows.Scan(&otherParticipantId, &chatId); err != nil {
+				Logger.Errorf("Error during scan common chat rows %v", err)
+				return nil, err
+			} else {
+				res[otherParticipantId] = append(res[otherParticipantId], chatId)
+			}
+		}
+		return res, nil
+	}
 }
@@ -0,0 +1,69 @@
+package db
+
+import (
+	. "nkonev.name/chat/logger"
+)
+
+// db model
+
+type ReactionCount struct {
+	Reaction string
+	Count    int64
+}
+
+func (tx *Tx) PutReaction(chatId, messageId, userId int64, reaction string) error {
+	_, err := tx.Exec(`INSERT INTO message_reaction (chat_id, message_id, user_id, reaction) VALUES ($1, $2, $3, $4) ON CONFLICT (chat_id, message_id, user_id) DO UPDATE SET reaction = $4, create_date_time = utc_now()`, chatId, messageId, userId, reaction)
+	if err != nil {
+		Logger.Errorf("Error during putting reaction %v", err)
+	}
+	return err
+}
+
+func (tx *Tx) DeleteReaction(chatId, messageId, userId int64) error {
+	_, err := tx.Exec(`DELETE FROM message_reaction WHERE chat_id = $1 AND message_id = $2 AND user_id = $3`, chatId, messageId, userId)
+	if err != nil {
+		Logger.Errorf("Error during deleting reaction %v", err)
+	}
+	return err
+}
+
+func (tx *Tx) DeleteMessageReactions(chatId, messageId int64) error {
+	_, err := tx.Exec(`DELETE FROM message_reaction WHERE chat_id = $1 AND message_id = $2`, chatId, messageId)
+	if err != nil {
+		Logger.Errorf("Error during deleting message reactions %v", err)
+	}
+	return err
+}
+
+// returns reactions aggregated by messageId, the most popular go first
+func getReactionsCommon(co CommonOperations, chatId int64, messageIds []int64) (map[int64][]*ReactionCount, error) {
+	res := map[int64][]*ReactionCount{}
+	if len(messageIds) == 0 {
+		return res, nil
+	}
+	rows, err := co.Query(`SELECT message_id, reaction, count(*) FROM message_reaction WHERE chat_id = $1 AND message_id = ANY($2) GROUP BY message_id, reaction ORDER BY message_id, count(*) DESC, min(create_date_time)`, chatId, messageIds)
+	if err != nil {
+		Logger.Errorf("Error during get reactions %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var messageId int64
+		var reactionCount = new(ReactionCount)
+		if err := rows.Scan(&messageId, &reactionCount.Reaction, &reactionCount.Count); err != nil {
+			Logger.Errorf("Error during scan reaction rows %v", err)
+			return nil, err
+		} else {
+			res[messageId] = append(res[messageId], reactionCount)
+		}
+	}
+	return res, nil
+}
+
+func (db *DB) GetReactions(chatId int64, messageIds []int64) (map[int64][]*ReactionCount, error) {
+	return getReactionsCommon(db, chatId, messageIds)
+}
+
+func (tx *Tx) GetReactions(chatId int64, messageIds []int64) (map[int64][]*ReactionCount, error) {
+	return getReactionsCommon(tx, chatId, messageIds)
+}
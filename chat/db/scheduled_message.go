@@ -0,0 +1,126 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	. "nkonev.name/chat/logger"
+	"time"
+)
+
+// db model
+
+type ScheduledMessage struct {
+	Id               int64
+	ChatId           int64
+	OwnerId          int64
+	Text             string
+	FileItemUuid     *uuid.UUID
+	ReplyToMessageId null.Int
+	SendAt           time.Time
+	CreateDateTime   time.Time
+	FailDateTime     null.Time // the failed message stays for its owner, but isn't sent
+}
+
+const selectScheduledMessageClause = `SELECT id, chat_id, owner_id, text, file_item_uuid, reply_to_message_id, send_at, create_date_time, fail_date_time FROM scheduled_message `
+
+func provideScanToScheduledMessage(message *ScheduledMessage) []interface{} {
+	return []interface{}{
+		&message.Id,
+		&message.ChatId,
+		&message.OwnerId,
+		&message.Text,
+		&message.FileItemUuid,
+		&message.ReplyToMessageId,
+		&message.SendAt,
+		&message.CreateDateTime,
+		&message.FailDateTime,
+	}
+}
+
+func createScheduledMessageCommon(co CommonOperations, m *ScheduledMessage) (int64, time.Time, error) {
+	var id int64
+	var createDateTime time.Time
+	res := co.QueryRow(`INSERT INTO scheduled_message (chat_id, owner_id, text, file_item_uuid, reply_to_message_id, send_at) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id, create_date_time`, m.ChatId, m.OwnerId, m.Text, m.FileItemUuid, m.ReplyToMessageId, m.SendAt)
+	if err := res.Scan(&id, &createDateTime); err != nil {
+		Logger.Errorf("Error during creating scheduled message %v", err)
+		return 0, createDateTime, err
+	}
+	return id, createDateTime, nil
+}
+
+func (db *DB) CreateScheduledMessage(m *ScheduledMessage) (int64, time.Time, error) {
+	return createScheduledMessageCommon(db, m)
+}
+
+func (tx *Tx) CreateScheduledMessage(m *ScheduledMessage) (int64, time.Time, error) {
+	return createScheduledMessageCommon(tx, m)
+}
+
+func (db *DB) GetScheduledMessages(chatId, ownerId int64) ([]*ScheduledMessage, error) {
+	rows, err := db.Query(selectScheduledMessageClause+`WHERE chat_id = $1 AND owner_id = $2 ORDER BY send_at, id`, chatId, ownerId)
+	if err != nil {
+		Logger.Errorf("Error during get scheduled messages %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+	list := make([]*ScheduledMessage, 0)
+	for rows.Next() {
+		message := ScheduledMessage{}
+		if err := rows.Scan(provideScanToScheduledMessage(&message)...); err != nil {
+			Logger.Errorf("Error during scan scheduled message rows %v", err)
+			return nil, err
+		} else {
+			list = append(list, &message)
+		}
+	}
+	return list, nil
+}
+
+// returns false if there is no such not yet sent message of the owner
+func (db *DB) DeleteScheduledMessage(id, chatId, ownerId int64) (bool, error) {
+	res, err := db.Exec(`DELETE FROM scheduled_message WHERE id = $1 AND chat_id = $2 AND owner_id = $3`, id, chatId, ownerId)
+	if err != nil {
+		Logger.Errorf("Error during deleting scheduled message %v", err)
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		Logger.Errorf("Error during checking rows affected %v", err)
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// locks the earliest due message, so it is going to be sent once even if several instances process the queue
+func (tx *Tx) GetNextDueScheduledMessage() (*ScheduledMessage, error) {
+	row := tx.QueryRow(selectScheduledMessageClause + `WHERE send_at <= utc_now() AND fail_date_time IS NULL ORDER BY send_at, id LIMIT 1 FOR UPDATE SKIP LOCKED`)
+	message := ScheduledMessage{}
+	err := row.Scan(provideScanToScheduledMessage(&message)...)
+	if errors.Is(err, sql.ErrNoRows) {
+		// there were no rows, but otherwise no error occurred
+		return nil, nil
+	}
+	if err != nil {
+		Logger.Errorf("Error during get due scheduled message %v", err)
+		return nil, err
+	}
+	return &message, nil
+}
+
+func (tx *Tx) DeleteScheduledMessageById(id int64) error {
+	_, err := tx.Exec(`DELETE FROM scheduled_message WHERE id = $1`, id)
+	if err != nil {
+		Logger.Errorf("Error during deleting scheduled message %v", err)
+	}
+	return err
+}
+
+func (db *DB) MarkScheduledMessageFailed(id int64) error {
+	_, err := db.Exec(`UPDATE scheduled_message SET fail_date_time = utc_now() WHERE id = $1`, id)
+	if err != nil {
+		Logger.Errorf("Error during marking scheduled message as failed %v", err)
+	}
+	return err
+}
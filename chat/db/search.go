@@ -0,0 +1,141 @@
+package db
+
+import (
+	"fmt"
+	. "nkonev.name/chat/logger"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// db model
+
+type FoundMessage struct {
+	Id             int64
+	ChatId         int64
+	OwnerId        int64
+	CreateDateTime time.Time
+	Highlight      string
+	Rank           float32
+}
+
+const (
+	searchConfigEnglish = "english"
+	searchConfigRussian = "russian"
+)
+
+// chooses the text search configuration according to the query language, text_search column contains lexemes for the both
+func searchConfig(searchString string) string {
+	for _, r := range searchString {
+		if unicode.Is(unicode.Cyrillic, r) {
+			return searchConfigRussian
+		}
+	}
+	return searchConfigEnglish
+}
+
+// searches over all the chats where user is participant, the most relevant go first
+func (db *DB) SearchMessages(userId int64, searchString string, limit, offset int) ([]*FoundMessage, error) {
+	return db.searchMessagesCommon(userId, searchString, limit, offset, nil)
+}
+
+// the same as SearchMessages, but continues after the given message instead of offset
+func (db *DB) SearchMessagesAfter(userId int64, searchString string, limit int, after *FoundMessage) ([]*FoundMessage, error) {
+	return db.searchMessagesCommon(userId, searchString, limit, 0, after)
+}
+
+// the not deleted chats of the user which have the message table, the table can be absent e.g. during the chat's creation
+func (db *DB) getChatIdsWithMessages(userId int64) ([]int64, error) {
+	rows, err := db.Query(`SELECT cp.chat_id FROM chat_participant cp JOIN chat c ON c.id = cp.chat_id AND c.delete_date_time IS NULL WHERE cp.user_id = $1 AND to_regclass('message_chat_' || cp.chat_id) IS NOT NULL`, userId)
+	if err != nil {
+		Logger.Errorf("Error during get chat ids of user %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+	chatIds := make([]int64, 0)
+	for rows.Next() {
+		var chatId int64
+		if err := rows.Scan(&chatId); err != nil {
+			Logger.Errorf("Error during scan chat id rows %v", err)
+			return nil, err
+		}
+		chatIds = append(chatIds, chatId)
+	}
+	return chatIds, nil
+}
+
+func (db *DB) searchMessagesCommon(userId int64, searchString string, limit, offset int, after *FoundMessage) ([]*FoundMessage, error) {
+	// only the tables of the user's chats are searched instead of the whole message hierarchy
+	chatIds, err := db.getChatIdsWithMessages(userId)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*FoundMessage, 0)
+	if len(chatIds) == 0 {
+		return list, nil
+	}
+	var chatTables = make([]string, 0, len(chatIds))
+	for _, chatId := range chatIds {
+		chatTables = append(chatTables, fmt.Sprintf(`SELECT %v AS chat_id, id, owner_id, create_date_time, text, text_search FROM message_chat_%v, query WHERE text_search @@ query.q`, chatId, chatId))
+	}
+
+	var args = []interface{}{searchConfig(searchString), searchString, limit, offset}
+	var afterClause = ""
+	if after != nil {
+		// keyset has the same order as ORDER BY
+		afterClause = "WHERE (ts_rank(m.text_search, q), m.create_date_time, m.chat_id, m.id) < ($5, $6, $7, $8)"
+		args = append(args, after.Rank, after.CreateDateTime, after.ChatId, after.Id)
+	}
+	rows, err := db.Query(fmt.Sprintf(`
+		WITH query AS (SELECT websearch_to_tsquery($1::regconfig, $2) q)
+		SELECT
+			m.chat_id,
+			m.id,
+			m.owner_id,
+			m.create_date_time,
+			ts_headline($1::regconfig, strip_tags(m.text), q, 'StartSel=<b>, StopSel=</b>, MaxFragments=2, MaxWords=32, MinWords=8'),
+			ts_rank(m.text_search, q) AS rank
+		FROM (%s) m
+		CROSS JOIN query
+		%s
+		ORDER BY rank DESC, m.create_date_time DESC, m.chat_id DESC, m.id DESC
+		LIMIT $3 OFFSET $4`, strings.Join(chatTables, " UNION ALL "), afterClause),
+		args...)
+	if err != nil {
+		Logger.Errorf("Error during search messages %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		found := new(FoundMessage)
+		if err := rows.Scan(&found.ChatId, &found.Id, &found.OwnerId, &found.CreateDateTime, &found.Highlight, &found.Rank); err != nil {
+			Logger.Errorf("Error during scan found message rows %v", err)
+			return nil, err
+		} else {
+			list = append(list, found)
+		}
+	}
+	return list, nil
+}
+
+// searches chats by title, continues after the given chat if it is present. Tet-a-tet chats have technical titles so they are found by the participant login instead
+func (db *DB) SearchChatsAfter(participantId int64, searchString string, limit int, after *Chat) ([]*Chat, error) {
+	afterClause, args := chatsAfterClause(after, []interface{}{participantId, limit, "%" + searchString + "%"})
+	rows, err := db.Query(fmt.Sprintf(`SELECT id, title, avatar, avatar_big, last_update_date_time, tet_a_tet FROM chat WHERE id IN ( SELECT chat_id FROM chat_participant WHERE user_id = $1 ) AND title ILIKE $3 AND tet_a_tet = false AND delete_date_time IS NULL %s ORDER BY (last_update_date_time, id) DESC LIMIT $2`, afterClause), args...)
+	if err != nil {
+		Logger.Errorf("Error during search chat rows %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+	list := make([]*Chat, 0)
+	for rows.Next() {
+		chat := Chat{}
+		if err := rows.Scan(&chat.Id, &chat.Title, &chat.Avatar, &chat.AvatarBig, &chat.LastUpdateDateTime, &chat.TetATet); err != nil {
+			Logger.Errorf("Error during scan chat rows %v", err)
+			return nil, err
+		} else {
+			list = append(list, &chat)
+		}
+	}
+	return list, nil
+}
@@ -13,6 +13,9 @@ func TransactWithResult(db DB, txFunc func(*Tx) (interface{}, error)) (ret inter
 			tx.Rollback() // err is non-nil; don't change it
 		} else {
 			err = tx.Commit() // err is nil; if Commit returns error update err
+			if err == nil {
+				tx.runAfterCommit()
+			}
 		}
 	}()
 	ret, err = txFunc(tx)
@@ -32,6 +35,9 @@ func Transact(db DB, txFunc func(*Tx) error) (err error) {
 			tx.Rollback() // err is non-nil; don't change it
 		} else {
 			err = tx.Commit() // err is nil; if Commit returns error update err
+			if err == nil {
+				tx.runAfterCommit()
+			}
 		}
 	}()
 	err = txFunc(tx)
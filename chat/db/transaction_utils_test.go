@@ -132,3 +132,24 @@ func TestTransactionWithResultNegative(t *testing.T) {
 	s := err.Error()
 	assert.Equal(t, `sql: no rows in result set`, s)
 }
+
+func TestTransactionAfterCommit(t *testing.T) {
+	var committed []string
+	err := Transact(*dbInstance, func(tx *Tx) error {
+		tx.AfterCommit(func() {
+			committed = append(committed, "positive")
+		})
+		return nil
+	})
+	assert.Nil(t, err)
+
+	err = Transact(*dbInstance, func(tx *Tx) error {
+		tx.AfterCommit(func() {
+			committed = append(committed, "negative")
+		})
+		_, err := tx.Exec("SELECT * FROM not_existing_table")
+		return err
+	})
+	assert.NotNil(t, err)
+	assert.Equal(t, []string{"positive"}, committed)
+}
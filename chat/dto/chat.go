@@ -16,23 +16,33 @@ type BaseChatDto struct {
 	CanDelete           null.Bool   `json:"canDelete"`
 	CanLeave            null.Bool   `json:"canLeave"`
 	UnreadMessages      int64       `json:"unreadMessages"`
+	UnreadMentions      int64       `json:"unreadMentions"`
 	CanBroadcast        bool        `json:"canBroadcast"`
 	CanVideoKick        bool        `json:"canVideoKick"`
 	CanChangeChatAdmins bool        `json:"canChangeChatAdmins"`
 	IsTetATet           bool        `json:"tetATet"`
 	CanAudioMute        bool        `json:"canAudioMute"`
 	ParticipantsCount   int         `json:"participantsCount"`
-}
-
-func (copied *BaseChatDto) SetPersonalizedFields(admin bool, unreadMessages int64) {
-	copied.CanEdit = null.BoolFrom(admin && !copied.IsTetATet)
-	copied.CanDelete = null.BoolFrom(admin)
-	copied.CanLeave = null.BoolFrom(!admin && !copied.IsTetATet)
+	Pinned              bool        `json:"pinned"`
+	Visibility          string      `json:"visibility"`
+	Role                string      `json:"role"`
+	IsChannel           bool        `json:"channel"`
+	CanWriteMessage     bool        `json:"canWriteMessage"`
+}
+
+func (copied *BaseChatDto) SetPersonalizedFields(role string, pinned bool, unreadMessages, unreadMentions int64) {
+	copied.Role = role
+	copied.CanEdit = null.BoolFrom(HasPermission(role, PermissionEditChat) && !copied.IsTetATet)
+	copied.CanDelete = null.BoolFrom(HasPermission(role, PermissionDeleteChat))
+	copied.CanLeave = null.BoolFrom(!IsAdminRole(role) && !copied.IsTetATet)
 	copied.UnreadMessages = unreadMessages
-	copied.CanVideoKick = admin
-	copied.CanAudioMute = admin
-	copied.CanChangeChatAdmins = admin && !copied.IsTetATet
-	copied.CanBroadcast = admin
+	copied.UnreadMentions = unreadMentions
+	copied.Pinned = pinned
+	copied.CanVideoKick = HasPermission(role, PermissionVideoKick)
+	copied.CanAudioMute = HasPermission(role, PermissionAudioMute)
+	copied.CanChangeChatAdmins = HasPermission(role, PermissionChangeRoles) && !copied.IsTetATet
+	copied.CanBroadcast = HasPermission(role, PermissionBroadcast)
+	copied.CanWriteMessage = CanWriteMessage(role, copied.IsChannel)
 }
 
 type ChatDeletedDto struct {
@@ -99,3 +109,20 @@ type ChatUnreadMessageChanged struct {
 	ChatId         int64 `json:"chatId"`
 	UnreadMessages int64 `json:"unreadMessages"`
 }
+
+// ChatSettingsDto are the participant's own settings of the chat
+type ChatSettingsDto struct {
+	Muted    bool `json:"muted"`    // muted chat doesn't make the global unread badge
+	Archived bool `json:"archived"` // archived chat is shown with the archived=true filter
+}
+
+// PublicChatDto is the chat in the public directory, it is shown to anyone, so it has no participants
+type PublicChatDto struct {
+	Id                 int64       `json:"id"`
+	Name               string      `json:"name"`
+	Avatar             null.String `json:"avatar"`
+	AvatarBig          null.String `json:"avatarBig"`
+	LastUpdateDateTime time.Time   `json:"lastUpdateDateTime"`
+	ParticipantsCount  int         `json:"participantsCount"`
+	IsChannel          bool        `json:"channel"`
+}
@@ -7,21 +7,109 @@ import (
 )
 
 type DisplayMessageDto struct {
-	Id             int64      `json:"id"`
-	Text           string     `json:"text"`
-	ChatId         int64      `json:"chatId"`
-	OwnerId        int64      `json:"ownerId"`
-	CreateDateTime time.Time  `json:"createDateTime"`
-	EditDateTime   null.Time  `json:"editDateTime"`
-	Owner          *User      `json:"owner"`
-	CanEdit        bool       `json:"canEdit"`
-	FileItemUuid   *uuid.UUID `json:"fileItemUuid"`
+	Id               int64              `json:"id"`
+	Text             string             `json:"text"`
+	ChatId           int64              `json:"chatId"`
+	OwnerId          int64              `json:"ownerId"`
+	CreateDateTime   time.Time          `json:"createDateTime"`
+	EditDateTime     null.Time          `json:"editDateTime"`
+	Owner            *User              `json:"owner"`
+	CanEdit          bool               `json:"canEdit"`
+	FileItemUuid     *uuid.UUID         `json:"fileItemUuid"`
+	ReplyToMessageId null.Int           `json:"replyToMessageId"`
+	ReplyTo          *ReplyToMessageDto `json:"replyTo"`
+	Reactions        []*ReactionDto     `json:"reactions"`
+	Pinned           bool               `json:"pinned"`
+	ReadBy           int64              `json:"readBy"` // how many participants have read the message, without the owner
+	ForwardedFrom    *ForwardedFromDto  `json:"forwardedFrom"`
+	Bot              bool               `json:"bot"` // the owner is a bot
+	LinkPreviews     []*LinkPreviewDto  `json:"linkPreviews"`
+}
+
+// "originally from" attribution of the forwarded message
+type ForwardedFromDto struct {
+	ChatId    int64 `json:"chatId"`
+	MessageId int64 `json:"messageId"`
+	OwnerId   int64 `json:"ownerId"`
+	Owner     *User `json:"owner"`
+}
+
+// quoted summary of the parent message
+type ReplyToMessageDto struct {
+	Id      int64  `json:"id"`
+	OwnerId int64  `json:"ownerId"`
+	Owner   *User  `json:"owner"`
+	Text    string `json:"text"` // without tags and shortened
 }
 
 func (copied *DisplayMessageDto) SetPersonalizedFields(participantId int64) {
 	copied.CanEdit = copied.OwnerId == participantId
 }
 
+type FoundMessageDto struct {
+	Id             int64     `json:"id"`
+	ChatId         int64     `json:"chatId"`
+	OwnerId        int64     `json:"ownerId"`
+	Owner          *User     `json:"owner"`
+	CreateDateTime time.Time `json:"createDateTime"`
+	Highlight      string    `json:"highlight"` // the matched fragments where the found words are wrapped into <b>
+	Rank           float32   `json:"rank"`
+}
+
+// the message where the user is mentioned
+type MentionDto struct {
+	ChatId         int64     `json:"chatId"`
+	MessageId      int64     `json:"messageId"`
+	OwnerId        int64     `json:"ownerId"`
+	Owner          *User     `json:"owner"`
+	Text           string    `json:"text"` // without tags and shortened
+	CreateDateTime time.Time `json:"createDateTime"`
+}
+
+type MessageReadDto struct {
+	ChatId        int64 `json:"chatId"`
+	LastMessageId int64 `json:"lastMessageId"` // all the messages up to this one are read by the user
+	UserId        int64 `json:"userId"`
+}
+
+// OpenGraph or oEmbed metadata of the link in the message, the previews are fetched after the message is saved
+type LinkPreviewDto struct {
+	Url         string      `json:"url"`
+	Title       null.String `json:"title"`
+	Description null.String `json:"description"`
+	ImageUrl    null.String `json:"imageUrl"`
+	SiteName    null.String `json:"siteName"`
+}
+
+type ReactionDto struct {
+	Reaction string `json:"reaction"`
+	Count    int64  `json:"count"`
+}
+
+type ReactionChangedDto struct {
+	MessageId int64          `json:"messageId"`
+	Reactions []*ReactionDto `json:"reactions"` // all the actual reactions of the message
+}
+
+// previous version of an edited message or a tombstone of a deleted one
+type MessageVersionDto struct {
+	MessageId       int64      `json:"messageId"`
+	OwnerId         int64      `json:"ownerId"`
+	Owner           *User      `json:"owner"`
+	Text            string     `json:"text"`
+	FileItemUuid    *uuid.UUID `json:"fileItemUuid"`
+	VersionDateTime time.Time  `json:"versionDateTime"` // when this version appeared
+	Action          string     `json:"action"`          // edited or deleted
+	ActionDateTime  time.Time  `json:"actionDateTime"`
+}
+
+type MessageHistoryDto struct {
+	MessageId int64                `json:"messageId"`
+	ChatId    int64                `json:"chatId"`
+	Deleted   bool                 `json:"deleted"`
+	Versions  []*MessageVersionDto `json:"versions"` // from the oldest, the current version of the existing message isn't included
+}
+
 type MessageDeletedDto struct {
 	Id     int64 `json:"id"`
 	ChatId int64 `json:"chatId"`
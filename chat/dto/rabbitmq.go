@@ -1,5 +1,10 @@
 package dto
 
+import (
+	"github.com/guregu/null"
+	"time"
+)
+
 type ChatEvent struct {
 	EventType                    string                        `json:"eventType"`
 	ChatId                       int64                         `json:"chatId"`
@@ -8,6 +13,8 @@ type ChatEvent struct {
 	MessageDeletedNotification   *MessageDeletedDto            `json:"messageDeletedNotification"`
 	UserTypingNotification       *UserTypingNotification       `json:"userTypingNotification"`
 	MessageBroadcastNotification *MessageBroadcastNotification `json:"messageBroadcastNotification"`
+	ReactionChangedNotification  *ReactionChangedDto           `json:"reactionChangedNotification"`
+	MessageReadNotification      *MessageReadDto               `json:"messageReadNotification"`
 }
 
 type GlobalEvent struct {
@@ -18,4 +25,49 @@ type GlobalEvent struct {
 	UserProfileNotification       *User                     `json:"userProfileNotification"`
 	UnreadMessagesNotification    *ChatUnreadMessageChanged `json:"unreadMessagesNotification"`
 	AllUnreadMessagesNotification *AllUnreadMessages        `json:"allUnreadMessagesNotification"`
+	MentionNotification           *MentionDto               `json:"mentionNotification"`
+}
+
+// WebhookEvent is published once per the chat's event for its outgoing webhooks, unlike ChatEvent and GlobalEvent
+// it isn't addressed to a participant and has no fields personalized for them
+type WebhookEvent struct {
+	EventType                    string                        `json:"eventType"`
+	ChatId                       int64                         `json:"chatId"`
+	UserIds                      []int64                       `json:"userIds"` // whom the event is about, e.g. the added participants of chat_created
+	ChatNotification             *WebhookChatDto               `json:"chatNotification,omitempty"`
+	MessageNotification          *DisplayMessageDto            `json:"messageNotification,omitempty"`
+	MessageDeletedNotification   *MessageDeletedDto            `json:"messageDeletedNotification,omitempty"`
+	UserTypingNotification       *UserTypingNotification       `json:"userTypingNotification,omitempty"`
+	MessageBroadcastNotification *MessageBroadcastNotification `json:"messageBroadcastNotification,omitempty"`
+	ReactionChangedNotification  *ReactionChangedDto           `json:"reactionChangedNotification,omitempty"`
+	MessageReadNotification      *MessageReadDto               `json:"messageReadNotification,omitempty"`
+}
+
+// WebhookChatDto is the part of BaseChatDto which is the same for all the participants
+type WebhookChatDto struct {
+	Id                 int64       `json:"id"`
+	Name               string      `json:"name"`
+	Avatar             null.String `json:"avatar"`
+	AvatarBig          null.String `json:"avatarBig"`
+	LastUpdateDateTime time.Time   `json:"lastUpdateDateTime"`
+	ParticipantIds     []int64     `json:"participantIds"`
+	ParticipantsCount  int         `json:"participantsCount"`
+	IsTetATet          bool        `json:"tetATet"`
+	Visibility         string      `json:"visibility"`
+	IsChannel          bool        `json:"channel"`
+}
+
+func NewWebhookChatDto(chat *BaseChatDto) *WebhookChatDto {
+	return &WebhookChatDto{
+		Id:                 chat.Id,
+		Name:               chat.Name,
+		Avatar:             chat.Avatar,
+		AvatarBig:          chat.AvatarBig,
+		LastUpdateDateTime: chat.LastUpdateDateTime,
+		ParticipantIds:     chat.ParticipantIds,
+		ParticipantsCount:  chat.ParticipantsCount,
+		IsTetATet:          chat.IsTetATet,
+		Visibility:         chat.Visibility,
+		IsChannel:          chat.IsChannel,
+	}
 }
@@ -0,0 +1,90 @@
+package dto
+
+// participant's roles from the most powerful
+const (
+	RoleOwner     = "owner"
+	RoleAdmin     = "admin"
+	RoleModerator = "moderator"
+	RoleMember    = "member"
+	RoleReadOnly  = "readonly"
+)
+
+type Permission string
+
+const (
+	PermissionEditChat           Permission = "editChat"
+	PermissionDeleteChat         Permission = "deleteChat"
+	PermissionManageParticipants Permission = "manageParticipants" // add, remove and invite
+	PermissionChangeRoles        Permission = "changeRoles"
+	PermissionBroadcast          Permission = "broadcast"
+	PermissionPinMessage         Permission = "pinMessage"
+	PermissionModerateMessages   Permission = "moderateMessages" // see the history and the deleted messages
+	PermissionWriteMessage       Permission = "writeMessage"
+	PermissionVideoKick          Permission = "videoKick"
+	PermissionAudioMute          Permission = "audioMute"
+	PermissionVideoRecord        Permission = "videoRecord"
+	PermissionManageWebhooks     Permission = "manageWebhooks"
+)
+
+var roleRanks = map[string]int{
+	RoleOwner:     4,
+	RoleAdmin:     3,
+	RoleModerator: 2,
+	RoleMember:    1,
+	RoleReadOnly:  0,
+}
+
+var rolePermissions = map[string][]Permission{
+	RoleOwner: {
+		PermissionEditChat, PermissionDeleteChat, PermissionManageParticipants, PermissionChangeRoles, PermissionBroadcast,
+		PermissionPinMessage, PermissionModerateMessages, PermissionWriteMessage, PermissionVideoKick, PermissionAudioMute, PermissionVideoRecord,
+		PermissionManageWebhooks,
+	},
+	RoleAdmin: {
+		PermissionEditChat, PermissionManageParticipants, PermissionChangeRoles, PermissionBroadcast,
+		PermissionPinMessage, PermissionModerateMessages, PermissionWriteMessage, PermissionVideoKick, PermissionAudioMute, PermissionVideoRecord,
+		PermissionManageWebhooks,
+	},
+	RoleModerator: {
+		PermissionBroadcast, PermissionPinMessage, PermissionModerateMessages, PermissionWriteMessage, PermissionVideoKick, PermissionAudioMute,
+	},
+	RoleMember: {
+		PermissionWriteMessage,
+	},
+	RoleReadOnly: {},
+}
+
+func IsValidRole(role string) bool {
+	_, ok := roleRanks[role]
+	return ok
+}
+
+// HasPermission is false for the unknown role, e.g. the empty one of non-participant
+func HasPermission(role string, permission Permission) bool {
+	for _, p := range rolePermissions[role] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAdminRole tells the roles which were the admins before the roles appeared
+func IsAdminRole(role string) bool {
+	return role == RoleOwner || role == RoleAdmin
+}
+
+// Outranks tells can the actor manage the target participant
+func Outranks(actorRole, targetRole string) bool {
+	return IsValidRole(actorRole) && IsValidRole(targetRole) && roleRanks[targetRole] < roleRanks[actorRole]
+}
+
+// CanAssignRole allows to change only the participants below the actor and only to the roles below the actor, so the owner is only transferred
+func CanAssignRole(actorRole, targetRole, newRole string) bool {
+	return HasPermission(actorRole, PermissionChangeRoles) && Outranks(actorRole, targetRole) && Outranks(actorRole, newRole)
+}
+
+// CanWriteMessage leaves the channel to the admins only
+func CanWriteMessage(role string, channel bool) bool {
+	return HasPermission(role, PermissionWriteMessage) && (!channel || IsAdminRole(role))
+}
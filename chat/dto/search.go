@@ -0,0 +1,40 @@
+package dto
+
+import (
+	"github.com/guregu/null"
+	"time"
+)
+
+type FoundChatDto struct {
+	Id                 int64       `json:"id"`
+	Name               string      `json:"name"`
+	Avatar             null.String `json:"avatar"`
+	LastUpdateDateTime time.Time   `json:"lastUpdateDateTime"`
+}
+
+type FoundUserDto struct {
+	User    *User   `json:"user"`
+	ChatIds []int64 `json:"chatIds"` // the common chats with the current user
+}
+
+type FoundChatsDto struct {
+	Items      []*FoundChatDto `json:"items"`
+	NextCursor null.String     `json:"nextCursor"`
+}
+
+type FoundMessagesDto struct {
+	Items      []*FoundMessageDto `json:"items"`
+	NextCursor null.String        `json:"nextCursor"`
+}
+
+type FoundUsersDto struct {
+	Items      []*FoundUserDto `json:"items"`
+	NextCursor null.String     `json:"nextCursor"`
+}
+
+// the groups which weren't requested are null
+type GlobalSearchDto struct {
+	Chats    *FoundChatsDto    `json:"chats"`
+	Messages *FoundMessagesDto `json:"messages"`
+	Users    *FoundUsersDto    `json:"users"`
+}
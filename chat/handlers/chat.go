@@ -8,6 +8,7 @@ import (
 	"github.com/guregu/null"
 	"github.com/labstack/echo/v4"
 	"github.com/microcosm-cc/bluemonday"
+	"github.com/spf13/viper"
 	"net/http"
 	"nkonev.name/chat/auth"
 	"nkonev.name/chat/client"
@@ -16,12 +17,14 @@ import (
 	. "nkonev.name/chat/logger"
 	"nkonev.name/chat/services"
 	"nkonev.name/chat/utils"
+	"strconv"
 	"strings"
 )
 
 type ChatWrapper struct {
-	Data  []*dto.ChatDto `json:"data"`
-	Count int64          `json:"totalCount"` // total chat number for this user
+	Data       []*dto.ChatDto `json:"data"`
+	Count      int64          `json:"totalCount"` // total chat number for this user
+	NextCursor null.String    `json:"nextCursor"` // to request the next page which isn't affected by the bumped chats, is null on the last page
 }
 
 type EditChatDto struct {
@@ -34,6 +37,8 @@ type CreateChatDto struct {
 	ParticipantIds *[]int64    `json:"participantIds"`
 	Avatar         null.String `json:"avatar"`
 	AvatarBig      null.String `json:"avatarBig"`
+	Visibility     null.String `json:"visibility"` // isn't changed if absent
+	Channel        null.Bool   `json:"channel"`    // isn't changed if absent
 }
 
 type ChatHandler struct {
@@ -47,12 +52,14 @@ func NewChatHandler(dbR db.DB, notificator services.Notifications, restClient cl
 	return &ChatHandler{db: dbR, notificator: notificator, restClient: restClient, policy: policy}
 }
 
+var chatVisibilityRule = validation.In(db.ChatVisibilityPrivate, db.ChatVisibilityPublic, db.ChatVisibilityLink)
+
 func (a *CreateChatDto) Validate() error {
-	return validation.ValidateStruct(a, validation.Field(&a.Name, validation.Required, validation.Length(1, 256)))
+	return validation.ValidateStruct(a, validation.Field(&a.Name, validation.Required, validation.Length(1, 256)), validation.Field(&a.Visibility, chatVisibilityRule))
 }
 
 func (a *EditChatDto) Validate() error {
-	return validation.ValidateStruct(a, validation.Field(&a.Name, validation.Required, validation.Length(1, 256)), validation.Field(&a.Id, validation.Required))
+	return validation.ValidateStruct(a, validation.Field(&a.Name, validation.Required, validation.Length(1, 256)), validation.Field(&a.Id, validation.Required), validation.Field(&a.Visibility, chatVisibilityRule))
 }
 
 func (ch *ChatHandler) GetChats(c echo.Context) error {
@@ -66,6 +73,31 @@ func (ch *ChatHandler) GetChats(c echo.Context) error {
 	size := utils.FixSizeString(c.QueryParam("size"))
 	offset := utils.GetOffset(page, size)
 
+	// cursor takes precedence over page
+	var after *db.Chat
+	if cursor := c.QueryParam("cursor"); cursor != "" {
+		var key chatCursor
+		if err := utils.DecodeCursor(cursor, &key); err != nil {
+			return c.JSON(http.StatusBadRequest, &utils.H{"message": errWrongCursor.Error()})
+		}
+		after = &db.Chat{Pinned: key.Pinned, LastUpdateDateTime: key.LastUpdateDateTime, Id: key.Id}
+		offset = 0
+	}
+
+	var archived null.Bool
+	if archivedString := c.QueryParam("archived"); archivedString != "" {
+		archivedValue, err := strconv.ParseBool(archivedString)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, &utils.H{"message": "Wrong archived"})
+		}
+		archived = null.BoolFrom(archivedValue)
+	}
+
+	chatType := c.QueryParam("type")
+	if chatType != "" && chatType != db.ChatTypeTetATet && chatType != db.ChatTypeChannel && chatType != db.ChatTypeGroup {
+		return c.JSON(http.StatusBadRequest, &utils.H{"message": "Wrong type"})
+	}
+
 	searchString := c.QueryParam("searchString")
 	searchString = strings.TrimSpace(searchString)
 	var dbChats []*db.ChatWithParticipants
@@ -73,19 +105,39 @@ func (ch *ChatHandler) GetChats(c echo.Context) error {
 		searchString = TrimAmdSanitize(ch.policy, searchString)
 	}
 
-	dbChats, err := ch.db.GetChatsWithParticipants(userPrincipalDto.UserId, size, offset, searchString, userPrincipalDto, 0, 0)
+	// one more to know is there the next page
+	dbChats, err := ch.db.GetChatsWithParticipants(userPrincipalDto.UserId, size+1, offset, after, archived, chatType, searchString, userPrincipalDto, 0, 0)
 	if err != nil {
 		GetLogEntry(c.Request().Context()).Errorf("Error get chats from db %v", err)
 		return err
 	}
-
-	chatDtos := make([]*dto.ChatDto, 0)
-	for _, cc := range dbChats {
-		messages, err := ch.db.GetUnreadMessagesCount(cc.Id, userPrincipalDto.UserId)
+	var nextCursor null.String
+	if len(dbChats) > size {
+		dbChats = dbChats[:size]
+		last := dbChats[len(dbChats)-1]
+		nextCursor, err = encodeNextCursor(chatCursor{Pinned: last.Pinned, LastUpdateDateTime: last.LastUpdateDateTime, Id: last.Id})
 		if err != nil {
 			return err
 		}
-		cd := convertToDto(cc, []*dto.User{}, messages)
+	}
+
+	// the counts of the whole page are taken at once, so the amount of queries doesn't depend on the page size
+	chatIds := make([]int64, 0, len(dbChats))
+	for _, cc := range dbChats {
+		chatIds = append(chatIds, cc.Id)
+	}
+	unreadMessages, err := ch.db.GetUnreadMessagesCounts(chatIds, userPrincipalDto.UserId)
+	if err != nil {
+		return err
+	}
+	unreadMentions, err := ch.db.GetUnreadMentionsCounts(chatIds, userPrincipalDto.UserId)
+	if err != nil {
+		return err
+	}
+
+	chatDtos := make([]*dto.ChatDto, 0)
+	for _, cc := range dbChats {
+		cd := convertToDto(cc, []*dto.User{}, unreadMessages[cc.Id], unreadMentions[cc.Id])
 		chatDtos = append(chatDtos, cd)
 	}
 
@@ -95,7 +147,7 @@ func (ch *ChatHandler) GetChats(c echo.Context) error {
 			participantIdSet[participantId] = true
 		}
 	}
-	var users = getUsersRemotelyOrEmpty(participantIdSet, ch.restClient, c)
+	var users = getOwnersOrEmpty(&ch.db, participantIdSet, ch.restClient, c)
 	for _, chatDto := range chatDtos {
 		for _, participantId := range chatDto.ParticipantIds {
 			user := users[participantId]
@@ -111,7 +163,7 @@ func (ch *ChatHandler) GetChats(c echo.Context) error {
 		return errors.New("Error during getting user chat count")
 	}
 	GetLogEntry(c.Request().Context()).Infof("Successfully returning %v chats", len(chatDtos))
-	return c.JSON(http.StatusOK, ChatWrapper{Data: chatDtos, Count: userChatCount})
+	return c.JSON(http.StatusOK, ChatWrapper{Data: chatDtos, Count: userChatCount, NextCursor: nextCursor})
 }
 
 func getChat(
@@ -132,17 +184,27 @@ func getChat(
 			return nil, nil
 		}
 
-		users, err := restClient.GetUsers(cc.ParticipantsIds, c.Request().Context())
-		if err != nil {
-			users = []*dto.User{}
-			GetLogEntry(c.Request().Context()).Warn("Error during getting users from aaa")
+		var participantIdSet = map[int64]bool{}
+		for _, participantId := range cc.ParticipantsIds {
+			participantIdSet[participantId] = true
+		}
+		participants := getOwnersOrEmpty(dbR, participantIdSet, restClient, c)
+		users := make([]*dto.User, 0)
+		for _, participantId := range cc.ParticipantsIds {
+			if user, ok := participants[participantId]; ok {
+				users = append(users, user)
+			}
 		}
 
 		unreadMessages, err := dbR.GetUnreadMessagesCount(cc.Id, behalfParticipantId)
 		if err != nil {
 			return nil, err
 		}
-		chatDto := convertToDto(cc, users, unreadMessages)
+		unreadMentions, err := dbR.GetUnreadMentionsCount(cc.Id, behalfParticipantId)
+		if err != nil {
+			return nil, err
+		}
+		chatDto := convertToDto(cc, users, unreadMessages, unreadMentions)
 
 		for _, participant := range users {
 			utils.ReplaceChatNameToLoginForTetATet(chatDto, participant, behalfParticipantId)
@@ -199,10 +261,11 @@ func (ch *ChatHandler) getChatWithAdminedUsers(c echo.Context, chat *dto.ChatDto
 		if err := deepcopy.Copy(copied, participant); err != nil {
 			GetLogEntry(c.Request().Context()).Errorf("error during performing deep copy user: %s", err)
 		} else {
-			if admin, err := commonDbOperations.IsAdmin(participant.Id, copiedChat.Id); err != nil {
-				GetLogEntry(c.Request().Context()).Warnf("Unable to get IsAdmin for user %v in chat %v from db", participant.Id, copiedChat.Id)
+			if role, err := commonDbOperations.GetParticipantRole(participant.Id, copiedChat.Id); err != nil {
+				GetLogEntry(c.Request().Context()).Warnf("Unable to get role for user %v in chat %v from db", participant.Id, copiedChat.Id)
 			} else {
-				copied.Admin = admin
+				copied.Admin = dto.IsAdminRole(role)
+				copied.Role = role
 			}
 
 			adminedUsers = append(adminedUsers, copied)
@@ -212,7 +275,7 @@ func (ch *ChatHandler) getChatWithAdminedUsers(c echo.Context, chat *dto.ChatDto
 	return copiedChat, nil
 }
 
-func convertToDto(c *db.ChatWithParticipants, users []*dto.User, unreadMessages int64) *dto.ChatDto {
+func convertToDto(c *db.ChatWithParticipants, users []*dto.User, unreadMessages, unreadMentions int64) *dto.ChatDto {
 	b := dto.BaseChatDto{
 		Id:             c.Id,
 		Name:           c.Title,
@@ -220,6 +283,8 @@ func convertToDto(c *db.ChatWithParticipants, users []*dto.User, unreadMessages
 		Avatar:         c.Avatar,
 		AvatarBig:      c.AvatarBig,
 		IsTetATet:      c.TetATet,
+		Visibility:     c.Visibility,
+		IsChannel:      c.Channel,
 
 		// see also services/notifications.go:75 chatNotifyCommon()
 
@@ -227,7 +292,7 @@ func convertToDto(c *db.ChatWithParticipants, users []*dto.User, unreadMessages
 		LastUpdateDateTime: c.LastUpdateDateTime,
 	}
 
-	b.SetPersonalizedFields(c.IsAdmin, unreadMessages)
+	b.SetPersonalizedFields(c.Role, c.Pinned, unreadMessages, unreadMentions)
 
 	return &dto.ChatDto{
 		BaseChatDto:  b,
@@ -252,14 +317,29 @@ func (ch *ChatHandler) CreateChat(c echo.Context) error {
 	}
 
 	errOuter := db.Transact(ch.db, func(tx *db.Tx) error {
+		if bindTo.ParticipantIds != nil {
+			if known, err := checkApiBots(c, tx, *bindTo.ParticipantIds); !known {
+				return err
+			}
+		}
 		id, _, err := tx.CreateChat(convertToCreatableChat(bindTo, ch.policy))
 		if err != nil {
 			return err
 		}
-		// add admin
-		if err := tx.AddParticipant(userPrincipalDto.UserId, id, true); err != nil {
+		// add owner
+		if err := tx.AddParticipant(userPrincipalDto.UserId, id, dto.RoleOwner); err != nil {
 			return err
 		}
+		if bindTo.Visibility.Valid {
+			if err := tx.SetChatVisibility(id, bindTo.Visibility.String); err != nil {
+				return err
+			}
+		}
+		if bindTo.Channel.Valid {
+			if err := tx.SetChatChannel(id, bindTo.Channel.Bool); err != nil {
+				return err
+			}
+		}
 
 		if bindTo.ParticipantIds != nil {
 			participantIds := *bindTo.ParticipantIds
@@ -268,7 +348,7 @@ func (ch *ChatHandler) CreateChat(c echo.Context) error {
 				if participantId == userPrincipalDto.UserId {
 					continue
 				}
-				if err := tx.AddParticipant(participantId, id, false); err != nil {
+				if err := tx.AddParticipant(participantId, id, dto.RoleMember); err != nil {
 					return err
 				}
 			}
@@ -311,16 +391,17 @@ func (ch *ChatHandler) DeleteChat(c echo.Context) error {
 	}
 
 	errOuter := db.Transact(ch.db, func(tx *db.Tx) error {
-		if admin, err := tx.IsAdmin(userPrincipalDto.UserId, chatId); err != nil {
+		if permitted, err := hasPermission(tx, userPrincipalDto.UserId, chatId, dto.PermissionDeleteChat); err != nil {
 			return err
-		} else if !admin {
-			return errors.New(fmt.Sprintf("User %v is not admin of chat %v", userPrincipalDto.UserId, chatId))
+		} else if !permitted {
+			return errors.New(fmt.Sprintf("User %v is not allowed to delete chat %v", userPrincipalDto.UserId, chatId))
 		}
 		ids, err := tx.GetAllParticipantIds(chatId)
 		if err != nil {
 			return err
 		}
-		if err := tx.DeleteChat(chatId); err != nil {
+		// the chat is purged after the retention period, see redis.PurgeDeletedChatsService
+		if err := tx.SoftDeleteChat(chatId); err != nil {
 			return err
 		}
 
@@ -333,6 +414,76 @@ func (ch *ChatHandler) DeleteChat(c echo.Context) error {
 	return errOuter
 }
 
+func (ch *ChatHandler) RestoreChat(c echo.Context) error {
+	chatId, err := GetPathParamAsInt64(c, "id")
+	if err != nil {
+		return err
+	}
+
+	var userPrincipalDto, ok = c.Get(utils.USER_PRINCIPAL_DTO).(*auth.AuthResult)
+	if !ok {
+		GetLogEntry(c.Request().Context()).Errorf("Error during getting auth context")
+		return errors.New("Error during getting auth context")
+	}
+
+	errOuter := db.Transact(ch.db, func(tx *db.Tx) error {
+		role, err := tx.GetDeletedChatParticipantRole(userPrincipalDto.UserId, chatId)
+		if err != nil {
+			return err
+		}
+		if role == "" {
+			return c.NoContent(http.StatusNotFound)
+		}
+		if !dto.IsAdminRole(role) {
+			return c.JSON(http.StatusUnauthorized, &utils.H{"message": "You have no access to this chat"})
+		}
+		if restored, err := tx.RestoreChat(chatId, viper.GetDuration("deletedChats.retention")); err != nil {
+			return err
+		} else if !restored {
+			// the retention period is over, the chat is waiting for the purge
+			return c.NoContent(http.StatusNotFound)
+		}
+
+		responseDto, err := getChat(tx, ch.restClient, c, chatId, userPrincipalDto.UserId, userPrincipalDto, 0, 0)
+		if err != nil {
+			return err
+		}
+		copiedChat, err := ch.getChatWithAdminedUsers(c, responseDto, tx)
+		if err != nil {
+			return c.NoContent(http.StatusInternalServerError)
+		}
+		ids, err := tx.GetAllParticipantIds(chatId)
+		if err != nil {
+			return err
+		}
+		ch.notificator.NotifyAboutNewChat(c, copiedChat, ids, tx)
+		return c.JSON(http.StatusOK, responseDto)
+	})
+	if errOuter != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error during act transaction %v", errOuter)
+	}
+	return errOuter
+}
+
+// PurgeDeletedChats deletes for good the chats whose retention period is over
+func (ch *ChatHandler) PurgeDeletedChats(maxChats int) {
+	ids, err := ch.db.GetChatsToPurge(viper.GetDuration("deletedChats.retention"), maxChats)
+	if err != nil {
+		return
+	}
+	for _, chatId := range ids {
+		err := db.Transact(ch.db, func(tx *db.Tx) error {
+			return tx.DeleteChat(chatId)
+		})
+		if err != nil {
+			// the chat stays deleted softly, and we will try it again next time, the other chats don't wait for it
+			Logger.Errorf("Error during purging chat %v %v", chatId, err)
+			continue
+		}
+		Logger.Infof("Chat %v is purged", chatId)
+	}
+}
+
 func (ch *ChatHandler) EditChat(c echo.Context) error {
 	var bindTo = new(EditChatDto)
 	if err := c.Bind(bindTo); err != nil {
@@ -350,70 +501,36 @@ func (ch *ChatHandler) EditChat(c echo.Context) error {
 		return errors.New("Error during getting auth context")
 	}
 
-	var userIdsToNotifyAboutChatCreated []int64
-	var userIdsToNotifyAboutChatDeleted []int64
-	var userIdsToNotifyAboutChatChanged []int64
 	errOuter := db.Transact(ch.db, func(tx *db.Tx) error {
-		if admin, err := tx.IsAdmin(userPrincipalDto.UserId, bindTo.Id); err != nil {
+		if permitted, err := hasPermission(tx, userPrincipalDto.UserId, bindTo.Id, dto.PermissionEditChat); err != nil {
 			return err
-		} else if !admin {
-			return errors.New(fmt.Sprintf("User %v is not admin of chat %v", userPrincipalDto.UserId, bindTo.Id))
+		} else if !permitted {
+			return errors.New(fmt.Sprintf("User %v is not allowed to edit chat %v", userPrincipalDto.UserId, bindTo.Id))
 		}
-		_, err := tx.EditChat(bindTo.Id, TrimAmdSanitize(ch.policy, bindTo.Name), bindTo.Avatar, bindTo.AvatarBig)
-		if err != nil {
-			return err
-		}
-
-		existsChatParticipantIdsFromDatabase, err := tx.GetAllParticipantIds(bindTo.Id)
-		if err != nil {
-			return err
-		}
-
 		if bindTo.ParticipantIds != nil {
-			// editing participants
-			participantIds := *bindTo.ParticipantIds
-			for _, participantIdFromRequest := range participantIds {
-				// not exists in database
-				if !utils.Contains(existsChatParticipantIdsFromDatabase, participantIdFromRequest) {
-					if err := tx.AddParticipant(participantIdFromRequest, bindTo.Id, false); err != nil {
-						return err
-					}
-					userIdsToNotifyAboutChatCreated = append(userIdsToNotifyAboutChatCreated, participantIdFromRequest)
-				} else { // exists in database
-					userIdsToNotifyAboutChatChanged = append(userIdsToNotifyAboutChatChanged, participantIdFromRequest)
-				}
+			if permitted, err := canRemoveMissingParticipants(tx, userPrincipalDto.UserId, bindTo.Id, *bindTo.ParticipantIds); err != nil {
+				return err
+			} else if !permitted {
+				return c.JSON(http.StatusUnauthorized, &utils.H{"message": "You can't remove this user"})
 			}
-			for _, participantIdFromDatabase := range existsChatParticipantIdsFromDatabase {
-				// not present in request array and not myself
-				if !utils.Contains(participantIds, participantIdFromDatabase) && participantIdFromDatabase != userPrincipalDto.UserId {
-					if err := tx.DeleteParticipant(participantIdFromDatabase, bindTo.Id); err != nil {
-						return err
-					}
-					userIdsToNotifyAboutChatDeleted = append(userIdsToNotifyAboutChatDeleted, participantIdFromDatabase)
+			// the bots which are already the participants, e.g. of the incoming webhooks, stay as they are
+			existingIds, err := tx.GetAllParticipantIds(bindTo.Id)
+			if err != nil {
+				return err
+			}
+			var newParticipantIds = []int64{}
+			for _, participantId := range *bindTo.ParticipantIds {
+				if !utils.Contains(existingIds, participantId) {
+					newParticipantIds = append(newParticipantIds, participantId)
 				}
 			}
-		} else {
-			// not editing participants - just sending notification about chat (name) change
-			if ids, err := tx.GetAllParticipantIds(bindTo.Id); err != nil {
+			if known, err := checkApiBots(c, tx, newParticipantIds); !known {
 				return err
-			} else {
-				for _, pid := range ids {
-					userIdsToNotifyAboutChatChanged = append(userIdsToNotifyAboutChatChanged, pid)
-				}
 			}
 		}
-
-		if responseDto, err := getChat(tx, ch.restClient, c, bindTo.Id, userPrincipalDto.UserId, userPrincipalDto, 0, 0); err != nil {
+		if responseDto, err := ch.editChat(c, tx, userPrincipalDto, bindTo); err != nil {
 			return err
 		} else {
-			copiedChat, err := ch.getChatWithAdminedUsers(c, responseDto, tx)
-			if err != nil {
-				return c.NoContent(http.StatusInternalServerError)
-			}
-
-			ch.notificator.NotifyAboutNewChat(c, copiedChat, userIdsToNotifyAboutChatCreated, tx)
-			ch.notificator.NotifyAboutDeleteChat(c, copiedChat.Id, userIdsToNotifyAboutChatDeleted, tx)
-			ch.notificator.NotifyAboutChangeChat(c, copiedChat, userIdsToNotifyAboutChatChanged, services.NoPagePlaceholder, tx)
 			return c.JSON(http.StatusAccepted, responseDto)
 		}
 	})
@@ -423,6 +540,112 @@ func (ch *ChatHandler) EditChat(c echo.Context) error {
 	return errOuter
 }
 
+// canRemoveMissingParticipants checks the participants which editChat removes because they are missing in participantIds
+// the same way as DeleteParticipant does
+func canRemoveMissingParticipants(tx *db.Tx, userId, chatId int64, participantIds []int64) (bool, error) {
+	existingIds, err := tx.GetAllParticipantIds(chatId)
+	if err != nil {
+		return false, err
+	}
+	myRole, err := tx.GetParticipantRole(userId, chatId)
+	if err != nil {
+		return false, err
+	}
+	for _, existingId := range existingIds {
+		if utils.Contains(participantIds, existingId) || existingId == userId {
+			continue
+		}
+		if !dto.HasPermission(myRole, dto.PermissionManageParticipants) {
+			return false, nil
+		}
+		role, err := tx.GetParticipantRole(existingId, chatId)
+		if err != nil {
+			return false, err
+		}
+		if !dto.Outranks(myRole, role) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// editChat saves the validated changes of the permitted user and notifies the participants
+func (ch *ChatHandler) editChat(c echo.Context, tx *db.Tx, userPrincipalDto *auth.AuthResult, bindTo *EditChatDto) (*dto.ChatDto, error) {
+	var userIdsToNotifyAboutChatCreated []int64
+	var userIdsToNotifyAboutChatDeleted []int64
+	var userIdsToNotifyAboutChatChanged []int64
+	_, err := tx.EditChat(bindTo.Id, TrimAmdSanitize(ch.policy, bindTo.Name), bindTo.Avatar, bindTo.AvatarBig)
+	if err != nil {
+		return nil, err
+	}
+	if bindTo.Visibility.Valid {
+		if err := tx.SetChatVisibility(bindTo.Id, bindTo.Visibility.String); err != nil {
+			return nil, err
+		}
+	}
+	if bindTo.Channel.Valid {
+		if err := tx.SetChatChannel(bindTo.Id, bindTo.Channel.Bool); err != nil {
+			return nil, err
+		}
+	}
+
+	existsChatParticipantIdsFromDatabase, err := tx.GetAllParticipantIds(bindTo.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if bindTo.ParticipantIds != nil {
+		// editing participants
+		participantIds := *bindTo.ParticipantIds
+		for _, participantIdFromRequest := range participantIds {
+			// not exists in database
+			if !utils.Contains(existsChatParticipantIdsFromDatabase, participantIdFromRequest) {
+				if err := tx.AddParticipant(participantIdFromRequest, bindTo.Id, dto.RoleMember); err != nil {
+					return nil, err
+				}
+				userIdsToNotifyAboutChatCreated = append(userIdsToNotifyAboutChatCreated, participantIdFromRequest)
+			} else { // exists in database
+				userIdsToNotifyAboutChatChanged = append(userIdsToNotifyAboutChatChanged, participantIdFromRequest)
+			}
+		}
+		for _, participantIdFromDatabase := range existsChatParticipantIdsFromDatabase {
+			// not present in request array and not myself
+			if !utils.Contains(participantIds, participantIdFromDatabase) && participantIdFromDatabase != userPrincipalDto.UserId {
+				if err := tx.DeleteParticipant(participantIdFromDatabase, bindTo.Id); err != nil {
+					return nil, err
+				}
+				userIdsToNotifyAboutChatDeleted = append(userIdsToNotifyAboutChatDeleted, participantIdFromDatabase)
+			}
+		}
+		if _, err := passOwnershipIfOrphaned(tx, bindTo.Id); err != nil {
+			return nil, err
+		}
+	} else {
+		// not editing participants - just sending notification about chat (name) change
+		if ids, err := tx.GetAllParticipantIds(bindTo.Id); err != nil {
+			return nil, err
+		} else {
+			for _, pid := range ids {
+				userIdsToNotifyAboutChatChanged = append(userIdsToNotifyAboutChatChanged, pid)
+			}
+		}
+	}
+
+	if responseDto, err := getChat(tx, ch.restClient, c, bindTo.Id, userPrincipalDto.UserId, userPrincipalDto, 0, 0); err != nil {
+		return nil, err
+	} else {
+		copiedChat, err := ch.getChatWithAdminedUsers(c, responseDto, tx)
+		if err != nil {
+			return nil, err
+		}
+
+		ch.notificator.NotifyAboutNewChat(c, copiedChat, userIdsToNotifyAboutChatCreated, tx)
+		ch.notificator.NotifyAboutDeleteChat(c, copiedChat.Id, userIdsToNotifyAboutChatDeleted, tx)
+		ch.notificator.NotifyAboutChangeChat(c, copiedChat, userIdsToNotifyAboutChatChanged, services.NoPagePlaceholder, tx)
+		return responseDto, nil
+	}
+}
+
 func (ch *ChatHandler) LeaveChat(c echo.Context) error {
 	chatId, err := GetPathParamAsInt64(c, "id")
 	if err != nil {
@@ -439,6 +662,9 @@ func (ch *ChatHandler) LeaveChat(c echo.Context) error {
 		if err := tx.DeleteParticipant(userPrincipalDto.UserId, chatId); err != nil {
 			return err
 		}
+		if _, err := passOwnershipIfOrphaned(tx, chatId); err != nil {
+			return err
+		}
 
 		firstUser, err := tx.GetFirstParticipant(chatId)
 		if err != nil {
@@ -462,8 +688,107 @@ func (ch *ChatHandler) LeaveChat(c echo.Context) error {
 	return errOuter
 }
 
+// passOwnershipIfOrphaned makes the longest-tenured admin or, if there are no admins, the longest-tenured participant the owner of the chat the owner has left.
+// Returns the new owner or 0 if the chat still has the owner or is empty
+func passOwnershipIfOrphaned(tx *db.Tx, chatId int64) (int64, error) {
+	ownersCount, err := tx.CountParticipantsWithRoles(chatId, []string{dto.RoleOwner})
+	if err != nil {
+		return 0, err
+	}
+	if ownersCount > 0 {
+		return 0, nil
+	}
+	successorId, err := tx.GetOwnerSuccessor(chatId)
+	if err != nil || successorId == 0 {
+		return 0, err
+	}
+	if err := tx.SetParticipantRole(successorId, chatId, dto.RoleOwner); err != nil {
+		return 0, err
+	}
+	Logger.Infof("User %v became the owner of chat %v", successorId, chatId)
+	return successorId, nil
+}
+
+// TransferOwnership makes the participant the owner, the former owner stays as an admin
+func (ch *ChatHandler) TransferOwnership(c echo.Context) error {
+	chatId, err := GetPathParamAsInt64(c, "id")
+	if err != nil {
+		return err
+	}
+	newOwnerId, err := GetPathParamAsInt64(c, "userId")
+	if err != nil {
+		return err
+	}
+
+	var userPrincipalDto, ok = c.Get(utils.USER_PRINCIPAL_DTO).(*auth.AuthResult)
+	if !ok || userPrincipalDto == nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error during getting auth context")
+		return errors.New("Error during getting auth context")
+	}
+
+	errOuter := db.Transact(ch.db, func(tx *db.Tx) error {
+		myRole, err := tx.GetParticipantRole(userPrincipalDto.UserId, chatId)
+		if err != nil {
+			return err
+		}
+		if myRole != dto.RoleOwner {
+			return c.JSON(http.StatusUnauthorized, &utils.H{"message": "You have no access to this chat"})
+		}
+		chat, err := tx.GetChat(userPrincipalDto.UserId, chatId)
+		if err != nil {
+			return err
+		}
+		if chat.TetATet {
+			return c.JSON(http.StatusBadRequest, &utils.H{"message": "Tet-a-tet chat has no single owner"})
+		}
+		if newOwnerId == userPrincipalDto.UserId {
+			return c.JSON(http.StatusBadRequest, &utils.H{"message": "You are already the owner"})
+		}
+		if db.IsBotOwnerId(newOwnerId) {
+			return c.JSON(http.StatusBadRequest, &utils.H{"message": "Bot can't own the chat"})
+		}
+		newOwnerRole, err := tx.GetParticipantRole(newOwnerId, chatId)
+		if err != nil {
+			return err
+		}
+		if newOwnerRole == "" {
+			return c.JSON(http.StatusBadRequest, &utils.H{"message": "User is not belong to chat"})
+		}
+
+		if err := tx.SetParticipantRole(newOwnerId, chatId, dto.RoleOwner); err != nil {
+			GetLogEntry(c.Request().Context()).Errorf("Error during changing chat role in database %v", err)
+			return err
+		}
+		if err := tx.SetParticipantRole(userPrincipalDto.UserId, chatId, dto.RoleAdmin); err != nil {
+			GetLogEntry(c.Request().Context()).Errorf("Error during changing chat role in database %v", err)
+			return err
+		}
+
+		participantIds, err := tx.GetAllParticipantIds(chatId)
+		if err != nil {
+			GetLogEntry(c.Request().Context()).Errorf("Error during getting chat participants %v", err)
+			return err
+		}
+		chatDto, err := getChat(tx, ch.restClient, c, chatId, userPrincipalDto.UserId, userPrincipalDto, 0, 0)
+		if err != nil {
+			return err
+		}
+		copiedChat, err := ch.getChatWithAdminedUsers(c, chatDto, tx)
+		if err != nil {
+			return c.NoContent(http.StatusInternalServerError)
+		}
+		ch.notificator.NotifyAboutChangeChat(c, copiedChat, participantIds, services.NoPagePlaceholder, tx)
+		return c.JSON(http.StatusAccepted, chatDto)
+	})
+	if errOuter != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error during act transaction %v", errOuter)
+	}
+	return errOuter
+}
+
 type ChangeAdminResponseDto struct {
-	Admin bool `json:"admin"`
+	Admin bool   `json:"admin"`
+	Role  string `json:"role"`
 }
 
 func (ch *ChatHandler) ChangeParticipant(c echo.Context) error {
@@ -484,35 +809,57 @@ func (ch *ChatHandler) ChangeParticipant(c echo.Context) error {
 
 	errOuter := db.Transact(ch.db, func(tx *db.Tx) error {
 
-		// check that I am admin
-		admin, err := tx.IsAdmin(userPrincipalDto.UserId, chatId)
+		myRole, err := tx.GetParticipantRole(userPrincipalDto.UserId, chatId)
 		if err != nil {
 			return err
 		}
-		if !admin {
+		if !dto.HasPermission(myRole, dto.PermissionChangeRoles) {
 			return c.JSON(http.StatusUnauthorized, &utils.H{"message": "You have no access to this chat"})
 		}
 		interestingUserId, err := GetPathParamAsInt64(c, "participantId")
-		participant, err := tx.IsParticipant(interestingUserId, chatId)
 		if err != nil {
 			return err
 		}
-		if !participant {
+		interestingUserRole, err := tx.GetParticipantRole(interestingUserId, chatId)
+		if err != nil {
+			return err
+		}
+		if interestingUserRole == "" {
 			return c.JSON(http.StatusBadRequest, &utils.H{"message": "User is not belong to chat"})
 		}
 
-		newAdmin, err := GetQueryParamAsBoolean(c, "admin")
-		if err != nil {
-			return err
+		// role takes precedence over the legacy admin flag
+		newRole := c.QueryParam("role")
+		if newRole == "" {
+			newAdmin, err := GetQueryParamAsBoolean(c, "admin")
+			if err != nil {
+				return err
+			}
+			newRole = dto.RoleMember
+			if newAdmin {
+				newRole = dto.RoleAdmin
+			}
 		}
-		err = tx.SetAdmin(interestingUserId, chatId, newAdmin)
-		if err != nil {
-			GetLogEntry(c.Request().Context()).Errorf("Error during changing chat admin in database %v", err)
-			return err
+		if !dto.IsValidRole(newRole) {
+			return c.JSON(http.StatusBadRequest, &utils.H{"message": "Unknown role"})
+		}
+		// the chat can't be left without the admins
+		if dto.IsAdminRole(interestingUserRole) && !dto.IsAdminRole(newRole) {
+			adminsCount, err := tx.CountParticipantsWithRoles(chatId, []string{dto.RoleOwner, dto.RoleAdmin})
+			if err != nil {
+				return err
+			}
+			if adminsCount <= 1 {
+				return c.JSON(http.StatusBadRequest, &utils.H{"message": "The last admin can't lose the admin rights"})
+			}
+		}
+		if !dto.CanAssignRole(myRole, interestingUserRole, newRole) {
+			return c.JSON(http.StatusUnauthorized, &utils.H{"message": "You can't assign this role to this user"})
 		}
-		isAdmin, err := tx.IsAdmin(interestingUserId, chatId)
+
+		err = tx.SetParticipantRole(interestingUserId, chatId, newRole)
 		if err != nil {
-			GetLogEntry(c.Request().Context()).Errorf("Error during getting chat admin in database %v", err)
+			GetLogEntry(c.Request().Context()).Errorf("Error during changing chat role in database %v", err)
 			return err
 		}
 		participantIds, err := tx.GetAllParticipantIds(chatId)
@@ -535,7 +882,7 @@ func (ch *ChatHandler) ChangeParticipant(c echo.Context) error {
 			}
 			ch.notificator.NotifyAboutChangeChat(c, copiedChat, userIdsToNotifyAboutChatChanged, participantsPage, tx)
 		}
-		responseDto := ChangeAdminResponseDto{Admin: isAdmin}
+		responseDto := ChangeAdminResponseDto{Admin: dto.IsAdminRole(newRole), Role: newRole}
 
 		return c.JSON(http.StatusAccepted, responseDto)
 	})
@@ -563,21 +910,33 @@ func (ch *ChatHandler) DeleteParticipant(c echo.Context) error {
 
 	errOuter := db.Transact(ch.db, func(tx *db.Tx) error {
 
-		// check that I am admin
-		admin, err := tx.IsAdmin(userPrincipalDto.UserId, chatId)
+		myRole, err := tx.GetParticipantRole(userPrincipalDto.UserId, chatId)
 		if err != nil {
 			return err
 		}
-		if !admin {
+		if !dto.HasPermission(myRole, dto.PermissionManageParticipants) {
 			return c.JSON(http.StatusUnauthorized, &utils.H{"message": "You have no access to this chat"})
 		}
 		interestingUserId, err := GetPathParamAsInt64(c, "participantId")
+		if err != nil {
+			return err
+		}
+		interestingUserRole, err := tx.GetParticipantRole(interestingUserId, chatId)
+		if err != nil {
+			return err
+		}
+		if interestingUserRole != "" && !dto.Outranks(myRole, interestingUserRole) {
+			return c.JSON(http.StatusUnauthorized, &utils.H{"message": "You can't remove this user"})
+		}
 
 		err = tx.DeleteParticipant(interestingUserId, chatId)
 		if err != nil {
 			GetLogEntry(c.Request().Context()).Errorf("Error during changing chat admin in database %v", err)
 			return err
 		}
+		if _, err := passOwnershipIfOrphaned(tx, chatId); err != nil {
+			return err
+		}
 		participantIds, err := tx.GetAllParticipantIds(chatId)
 		if err != nil {
 			GetLogEntry(c.Request().Context()).Errorf("Error during getting chat participants %v", err)
@@ -630,12 +989,9 @@ func (ch *ChatHandler) AddParticipants(c echo.Context) error {
 
 	errOuter := db.Transact(ch.db, func(tx *db.Tx) error {
 
-		// check that I am admin
-		admin, err := tx.IsAdmin(userPrincipalDto.UserId, chatId)
-		if err != nil {
+		if permitted, err := hasPermission(tx, userPrincipalDto.UserId, chatId, dto.PermissionManageParticipants); err != nil {
 			return err
-		}
-		if !admin {
+		} else if !permitted {
 			return c.JSON(http.StatusUnauthorized, &utils.H{"message": "You have no access to this chat"})
 		}
 		var bindTo = new(AddParticipantsDto)
@@ -644,33 +1000,13 @@ func (ch *ChatHandler) AddParticipants(c echo.Context) error {
 			return err
 		}
 
-		for _, participantId := range bindTo.ParticipantIds {
-			err = tx.AddParticipant(participantId, chatId, false)
-			if err != nil {
-				GetLogEntry(c.Request().Context()).Errorf("Error during changing chat admin in database %v", err)
-				return err
-			}
-		}
-		participantIds, err := tx.GetAllParticipantIds(chatId)
-		if err != nil {
-			GetLogEntry(c.Request().Context()).Errorf("Error during getting chat participants %v", err)
+		if known, err := checkApiBots(c, tx, bindTo.ParticipantIds); !known {
 			return err
 		}
 
-		var userIdsToNotifyAboutChatChanged []int64
-		for _, participantIdFromRequest := range participantIds {
-			userIdsToNotifyAboutChatChanged = append(userIdsToNotifyAboutChatChanged, participantIdFromRequest)
-		}
-		if chatDto, err := getChat(tx, ch.restClient, c, chatId, userPrincipalDto.UserId, userPrincipalDto, participantsSize, participantsOffset); err != nil {
+		if err := ch.addParticipants(c, tx, userPrincipalDto, chatId, bindTo.ParticipantIds, participantsPage, participantsSize, participantsOffset); err != nil {
 			return err
-		} else {
-			copiedChat, err := ch.getChatWithAdminedUsers(c, chatDto, tx)
-			if err != nil {
-				return c.NoContent(http.StatusInternalServerError)
-			}
-			ch.notificator.NotifyAboutChangeChat(c, copiedChat, userIdsToNotifyAboutChatChanged, participantsPage, tx)
 		}
-
 		return c.NoContent(http.StatusAccepted)
 	})
 	if errOuter != nil {
@@ -679,6 +1015,56 @@ func (ch *ChatHandler) AddParticipants(c echo.Context) error {
 	return errOuter
 }
 
+// checkApiBots responds 400 and returns false if any of the negative participant ids isn't a bot with the api token
+func checkApiBots(c echo.Context, tx *db.Tx, participantIds []int64) (bool, error) {
+	var botIds = []int64{}
+	for _, participantId := range participantIds {
+		if db.IsBotOwnerId(participantId) {
+			botIds = append(botIds, participantId)
+		}
+	}
+	if len(botIds) == 0 {
+		return true, nil
+	}
+	if count, err := tx.CountApiBots(botIds); err != nil {
+		return false, err
+	} else if count != len(botIds) {
+		return false, c.JSON(http.StatusBadRequest, &utils.H{"message": "Unknown bot"})
+	}
+	return true, nil
+}
+
+// addParticipants adds the members on behalf of the permitted user and notifies the participants
+func (ch *ChatHandler) addParticipants(c echo.Context, tx *db.Tx, userPrincipalDto *auth.AuthResult, chatId int64, newParticipantIds []int64, participantsPage, participantsSize, participantsOffset int) error {
+	for _, participantId := range newParticipantIds {
+		err := tx.AddParticipant(participantId, chatId, dto.RoleMember)
+		if err != nil {
+			GetLogEntry(c.Request().Context()).Errorf("Error during changing chat admin in database %v", err)
+			return err
+		}
+	}
+	participantIds, err := tx.GetAllParticipantIds(chatId)
+	if err != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error during getting chat participants %v", err)
+		return err
+	}
+
+	var userIdsToNotifyAboutChatChanged []int64
+	for _, participantIdFromRequest := range participantIds {
+		userIdsToNotifyAboutChatChanged = append(userIdsToNotifyAboutChatChanged, participantIdFromRequest)
+	}
+	if chatDto, err := getChat(tx, ch.restClient, c, chatId, userPrincipalDto.UserId, userPrincipalDto, participantsSize, participantsOffset); err != nil {
+		return err
+	} else {
+		copiedChat, err := ch.getChatWithAdminedUsers(c, chatDto, tx)
+		if err != nil {
+			return err
+		}
+		ch.notificator.NotifyAboutChangeChat(c, copiedChat, userIdsToNotifyAboutChatChanged, participantsPage, tx)
+	}
+	return nil
+}
+
 func (ch *ChatHandler) SearchForUsersToAdd(c echo.Context) error {
 	chatId, err := GetPathParamAsInt64(c, "id")
 	if err != nil {
@@ -691,11 +1077,9 @@ func (ch *ChatHandler) SearchForUsersToAdd(c echo.Context) error {
 		return errors.New("Error during getting auth context")
 	}
 
-	admin, err := ch.db.IsAdmin(userPrincipalDto.UserId, chatId)
-	if err != nil {
+	if permitted, err := hasPermission(&ch.db, userPrincipalDto.UserId, chatId, dto.PermissionManageParticipants); err != nil {
 		return err
-	}
-	if !admin {
+	} else if !permitted {
 		return c.JSON(http.StatusUnauthorized, &utils.H{"message": "You have no access to this chat"})
 	}
 
@@ -742,7 +1126,13 @@ func (ch *ChatHandler) IsAdmin(c echo.Context) error {
 	if err != nil {
 		return err
 	}
-	isAdmin, err := ch.db.IsAdmin(userId, chatId)
+	// the video service asks for the concrete permission, without it the admin role is checked
+	var isAdmin bool
+	if permission := c.QueryParam("permission"); permission != "" {
+		isAdmin, err = hasPermission(&ch.db, userId, chatId, dto.Permission(permission))
+	} else {
+		isAdmin, err = ch.db.IsAdmin(userId, chatId)
+	}
 	if err != nil {
 		return err
 	}
@@ -787,10 +1177,10 @@ func (ch *ChatHandler) TetATet(c echo.Context) error {
 			return err
 		}
 
-		if err := tx.AddParticipant(userPrincipalDto.UserId, chatId2, true); err != nil {
+		if err := tx.AddParticipant(userPrincipalDto.UserId, chatId2, dto.RoleOwner); err != nil {
 			return err
 		}
-		if err := tx.AddParticipant(toParticipantId, chatId2, true); err != nil {
+		if err := tx.AddParticipant(toParticipantId, chatId2, dto.RoleOwner); err != nil {
 			return err
 		}
 
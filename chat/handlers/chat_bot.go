@@ -0,0 +1,212 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/guregu/null"
+	"github.com/labstack/echo/v4"
+	"github.com/spf13/viper"
+	"net/http"
+	"nkonev.name/chat/auth"
+	"nkonev.name/chat/db"
+	. "nkonev.name/chat/logger"
+	"nkonev.name/chat/utils"
+	"time"
+)
+
+type CreateBotDto struct {
+	Name   string      `json:"name"`
+	Avatar null.String `json:"avatar"`
+}
+
+type BotDto struct {
+	Id             int64       `json:"id"`
+	UserId         int64       `json:"userId"` // is used as the participant id and the message owner id
+	Name           string      `json:"name"`
+	Avatar         null.String `json:"avatar"`
+	Token          null.String `json:"token,omitempty"` // is shown only once, after creation or regeneration
+	CreatorId      int64       `json:"creatorId"`
+	CreateDateTime time.Time   `json:"createDateTime"`
+}
+
+type BotEventDto struct {
+	Id             int64           `json:"id"`
+	EventType      string          `json:"eventType"`
+	Payload        json.RawMessage `json:"payload"` // ChatEvent or GlobalEvent
+	CreateDateTime time.Time       `json:"createDateTime"`
+}
+
+func (a *CreateBotDto) Validate() error {
+	return validation.ValidateStruct(a,
+		validation.Field(&a.Name, validation.Required, validation.Length(1, 256)),
+		validation.Field(&a.Avatar, validation.Length(1, 1024)),
+	)
+}
+
+func convertToBotDto(bot *db.Bot) *BotDto {
+	return &BotDto{
+		Id:             bot.Id,
+		UserId:         db.BotOwnerId(bot.Id),
+		Name:           bot.Name,
+		Avatar:         bot.Avatar,
+		CreatorId:      bot.CreatorId,
+		CreateDateTime: bot.CreateDateTime,
+	}
+}
+
+// CreateBot makes the bot which authenticates with the api token, the bot becomes a participant as a regular user does
+func (ch *ChatHandler) CreateBot(c echo.Context) error {
+	var bindTo = new(CreateBotDto)
+	if err := c.Bind(bindTo); err != nil {
+		GetLogEntry(c.Request().Context()).Warnf("Error during binding to dto %v", err)
+		return err
+	}
+	if valid, err := ValidateAndRespondError(c, bindTo); err != nil || !valid {
+		return err
+	}
+
+	var userPrincipalDto, ok = c.Get(utils.USER_PRINCIPAL_DTO).(*auth.AuthResult)
+	if !ok {
+		GetLogEntry(c.Request().Context()).Errorf("Error during getting auth context")
+		return errors.New("Error during getting auth context")
+	}
+
+	token, err := generateWebhookSecret()
+	if err != nil {
+		return err
+	}
+
+	errOuter := db.Transact(ch.db, func(tx *db.Tx) error {
+		bot := &db.Bot{
+			Name:      bindTo.Name,
+			Avatar:    bindTo.Avatar,
+			CreatorId: userPrincipalDto.UserId,
+			TokenHash: null.StringFrom(HashBotToken(token)),
+		}
+		if err := tx.CreateBot(bot); err != nil {
+			return err
+		}
+		botDto := convertToBotDto(bot)
+		botDto.Token = null.StringFrom(token)
+		return c.JSON(http.StatusCreated, botDto)
+	})
+	if errOuter != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error during act transaction %v", errOuter)
+	}
+	return errOuter
+}
+
+// GetBots returns the bots the user has created
+func (ch *ChatHandler) GetBots(c echo.Context) error {
+	var userPrincipalDto, ok = c.Get(utils.USER_PRINCIPAL_DTO).(*auth.AuthResult)
+	if !ok {
+		GetLogEntry(c.Request().Context()).Errorf("Error during getting auth context")
+		return errors.New("Error during getting auth context")
+	}
+
+	bots, err := ch.db.GetApiBotsByCreator(userPrincipalDto.UserId)
+	if err != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error get bots from db %v", err)
+		return err
+	}
+	botDtos := make([]*BotDto, 0)
+	for _, bot := range bots {
+		botDtos = append(botDtos, convertToBotDto(bot))
+	}
+	return c.JSON(http.StatusOK, botDtos)
+}
+
+// RegenerateBotToken replaces the api token of the bot, the old one stops working immediately
+func (ch *ChatHandler) RegenerateBotToken(c echo.Context) error {
+	var userPrincipalDto, ok = c.Get(utils.USER_PRINCIPAL_DTO).(*auth.AuthResult)
+	if !ok {
+		GetLogEntry(c.Request().Context()).Errorf("Error during getting auth context")
+		return errors.New("Error during getting auth context")
+	}
+
+	botId, err := GetPathParamAsInt64(c, "botId")
+	if err != nil {
+		return err
+	}
+
+	token, err := generateWebhookSecret()
+	if err != nil {
+		return err
+	}
+
+	errOuter := db.Transact(ch.db, func(tx *db.Tx) error {
+		if updated, err := tx.SetBotTokenHash(botId, userPrincipalDto.UserId, HashBotToken(token)); err != nil {
+			return err
+		} else if !updated {
+			return c.NoContent(http.StatusNotFound)
+		}
+		return c.JSON(http.StatusOK, &utils.H{"token": token})
+	})
+	if errOuter != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error during act transaction %v", errOuter)
+	}
+	return errOuter
+}
+
+// GetBotEvents is the long polling for the bot, it waits up to the timeout for the events after the given one.
+// The bot passes the id of the last received event as the after parameter
+func (ch *ChatHandler) GetBotEvents(c echo.Context) error {
+	var userPrincipalDto, ok = c.Get(utils.USER_PRINCIPAL_DTO).(*auth.AuthResult)
+	if !ok {
+		GetLogEntry(c.Request().Context()).Errorf("Error during getting auth context")
+		return errors.New("Error during getting auth context")
+	}
+	if !db.IsBotOwnerId(userPrincipalDto.UserId) {
+		return c.JSON(http.StatusUnauthorized, &utils.H{"message": "Only bots receive the events"})
+	}
+	botId := -userPrincipalDto.UserId
+
+	var afterId int64
+	if afterString := c.QueryParam("after"); afterString != "" {
+		var err error
+		if afterId, err = utils.ParseInt64(afterString); err != nil {
+			return c.JSON(http.StatusBadRequest, &utils.H{"message": "Wrong after"})
+		}
+	}
+	size := utils.FixSizeString(c.QueryParam("size"))
+
+	timeout := viper.GetDuration("bots.longPoll.timeout")
+	if timeoutString := c.QueryParam("timeout"); timeoutString != "" {
+		seconds, err := utils.ParseInt64(timeoutString)
+		if err != nil || seconds < 0 {
+			return c.JSON(http.StatusBadRequest, &utils.H{"message": "Wrong timeout"})
+		}
+		if requested := time.Duration(seconds) * time.Second; requested < timeout {
+			timeout = requested
+		}
+	}
+	interval := viper.GetDuration("bots.longPoll.interval")
+	deadline := time.Now().Add(timeout)
+
+	for {
+		events, err := ch.db.GetBotEvents(botId, afterId, size)
+		if err != nil {
+			GetLogEntry(c.Request().Context()).Errorf("Error get bot events from db %v", err)
+			return err
+		}
+		if len(events) > 0 || !time.Now().Before(deadline) {
+			eventDtos := make([]*BotEventDto, 0)
+			for _, event := range events {
+				eventDtos = append(eventDtos, &BotEventDto{
+					Id:             event.Id,
+					EventType:      event.EventType,
+					Payload:        json.RawMessage(event.Payload),
+					CreateDateTime: event.CreateDateTime,
+				})
+			}
+			return c.JSON(http.StatusOK, eventDtos)
+		}
+		select {
+		case <-c.Request().Context().Done():
+			GetLogEntry(c.Request().Context()).Infof("Bot %v has gone before the events", botId)
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}
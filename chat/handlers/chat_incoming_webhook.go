@@ -0,0 +1,305 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/guregu/null"
+	"github.com/labstack/echo/v4"
+	"golang.org/x/time/rate"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"nkonev.name/chat/auth"
+	"nkonev.name/chat/db"
+	. "nkonev.name/chat/logger"
+	"nkonev.name/chat/utils"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type CreateIncomingWebhookDto struct {
+	Name   string      `json:"name"` // the name of the bot which writes the messages
+	Avatar null.String `json:"avatar"`
+}
+
+type IncomingWebhookDto struct {
+	Token          string    `json:"token"`
+	ChatId         int64     `json:"chatId"`
+	BotId          int64     `json:"botId"`
+	BotName        string    `json:"botName"`
+	CreatorId      int64     `json:"creatorId"`
+	CreateDateTime time.Time `json:"createDateTime"`
+}
+
+// IncomingWebhookMessageDto is the subset of Slack's incoming webhook payload, the text is escaped the Slack's way
+type IncomingWebhookMessageDto struct {
+	Text string `json:"text"`
+}
+
+// the text is up to 1 MiB as in PostMessage, the rest is for the JSON or the form escaping
+const maxIncomingWebhookPayloadSize = 4 * 1024 * 1024
+
+var errIncomingWebhookPayloadTooLarge = errors.New("the payload is too large")
+
+func (a *IncomingWebhookMessageDto) Validate() error {
+	return validation.ValidateStruct(a, validation.Field(&a.Text, validation.Required, validation.Length(1, 1024*1024)))
+}
+
+func (a *CreateIncomingWebhookDto) Validate() error {
+	return validation.ValidateStruct(a,
+		validation.Field(&a.Name, validation.Required, validation.Length(1, 256)),
+		validation.Field(&a.Avatar, validation.Length(1, 1024)),
+	)
+}
+
+func convertToIncomingWebhookDto(hook *db.IncomingWebhook) *IncomingWebhookDto {
+	return &IncomingWebhookDto{
+		Token:          hook.Token,
+		ChatId:         hook.ChatId,
+		BotId:          hook.BotId,
+		BotName:        hook.BotName,
+		CreatorId:      hook.CreatorId,
+		CreateDateTime: hook.CreateDateTime,
+	}
+}
+
+// tokenRateLimiter keeps a token bucket per incoming webhook, the limits are per instance of chat
+type tokenRateLimiter struct {
+	mutex    sync.Mutex
+	limiters map[string]*rate.Limiter
+	limit    rate.Limit
+	burst    int
+}
+
+// newTokenRateLimiter allows the burst of requests and refills it during the period
+func newTokenRateLimiter(requests int, period time.Duration) *tokenRateLimiter {
+	return &tokenRateLimiter{
+		limiters: map[string]*rate.Limiter{},
+		limit:    rate.Limit(float64(requests) / period.Seconds()),
+		burst:    requests,
+	}
+}
+
+// reserve returns zero if the request is allowed or the delay after which it would be
+func (l *tokenRateLimiter) reserve(token string) time.Duration {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	limiter, ok := l.limiters[token]
+	if !ok {
+		limiter = rate.NewLimiter(l.limit, l.burst)
+		l.limiters[token] = limiter
+	}
+	now := time.Now()
+	reservation := limiter.ReserveN(now, 1)
+	if !reservation.OK() {
+		return time.Duration(math.MaxInt64)
+	}
+	delay := reservation.DelayFrom(now)
+	if delay > 0 {
+		reservation.CancelAt(now)
+	}
+	return delay
+}
+
+// readIncomingWebhookText accepts the plain text, the Slack's JSON or the Slack's form with the JSON in the payload field.
+// The endpoint is public, so no more than maxIncomingWebhookPayloadSize is read
+func readIncomingWebhookText(c echo.Context) (string, error) {
+	body, err := io.ReadAll(io.LimitReader(c.Request().Body, maxIncomingWebhookPayloadSize+1))
+	if err != nil {
+		return "", err
+	}
+	if len(body) > maxIncomingWebhookPayloadSize {
+		return "", errIncomingWebhookPayloadTooLarge
+	}
+	contentType := c.Request().Header.Get(echo.HeaderContentType)
+	var payload []byte
+	switch {
+	case strings.HasPrefix(contentType, echo.MIMEApplicationJSON):
+		payload = body
+	case strings.HasPrefix(contentType, echo.MIMEApplicationForm):
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			return "", err
+		}
+		payload = []byte(form.Get("payload"))
+	default:
+		return string(body), nil
+	}
+	var bindTo = new(IncomingWebhookMessageDto)
+	if err := json.Unmarshal(payload, bindTo); err != nil {
+		return "", err
+	}
+	return bindTo.Text, nil
+}
+
+func (ch *ChatHandler) CreateIncomingWebhook(c echo.Context) error {
+	var bindTo = new(CreateIncomingWebhookDto)
+	if err := c.Bind(bindTo); err != nil {
+		GetLogEntry(c.Request().Context()).Warnf("Error during binding to dto %v", err)
+		return err
+	}
+	if valid, err := ValidateAndRespondError(c, bindTo); err != nil || !valid {
+		return err
+	}
+
+	var userPrincipalDto, ok = c.Get(utils.USER_PRINCIPAL_DTO).(*auth.AuthResult)
+	if !ok {
+		GetLogEntry(c.Request().Context()).Errorf("Error during getting auth context")
+		return errors.New("Error during getting auth context")
+	}
+
+	chatId, err := GetPathParamAsInt64(c, "id")
+	if err != nil {
+		return err
+	}
+
+	errOuter := db.Transact(ch.db, func(tx *db.Tx) error {
+		if permitted, err := checkManageWebhooks(c, tx, userPrincipalDto.UserId, chatId); !permitted {
+			return err
+		}
+
+		bot := &db.Bot{
+			Name:      bindTo.Name,
+			Avatar:    bindTo.Avatar,
+			CreatorId: userPrincipalDto.UserId,
+		}
+		if err := tx.CreateBot(bot); err != nil {
+			return err
+		}
+		token, err := generateWebhookSecret()
+		if err != nil {
+			return err
+		}
+		hook := &db.IncomingWebhook{
+			Token:     token,
+			ChatId:    chatId,
+			BotId:     bot.Id,
+			BotName:   bot.Name,
+			CreatorId: userPrincipalDto.UserId,
+		}
+		if err := tx.CreateIncomingWebhook(hook); err != nil {
+			return err
+		}
+		return c.JSON(http.StatusCreated, convertToIncomingWebhookDto(hook))
+	})
+	if errOuter != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error during act transaction %v", errOuter)
+	}
+	return errOuter
+}
+
+func (ch *ChatHandler) GetIncomingWebhooks(c echo.Context) error {
+	var userPrincipalDto, ok = c.Get(utils.USER_PRINCIPAL_DTO).(*auth.AuthResult)
+	if !ok {
+		GetLogEntry(c.Request().Context()).Errorf("Error during getting auth context")
+		return errors.New("Error during getting auth context")
+	}
+
+	chatId, err := GetPathParamAsInt64(c, "id")
+	if err != nil {
+		return err
+	}
+
+	if permitted, err := checkManageWebhooks(c, &ch.db, userPrincipalDto.UserId, chatId); !permitted {
+		return err
+	}
+
+	hooks, err := ch.db.GetIncomingWebhooks(chatId)
+	if err != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error get incoming webhooks from db %v", err)
+		return err
+	}
+	hookDtos := make([]*IncomingWebhookDto, 0)
+	for _, hook := range hooks {
+		hookDtos = append(hookDtos, convertToIncomingWebhookDto(hook))
+	}
+	return c.JSON(http.StatusOK, hookDtos)
+}
+
+func (ch *ChatHandler) DeleteIncomingWebhook(c echo.Context) error {
+	var userPrincipalDto, ok = c.Get(utils.USER_PRINCIPAL_DTO).(*auth.AuthResult)
+	if !ok {
+		GetLogEntry(c.Request().Context()).Errorf("Error during getting auth context")
+		return errors.New("Error during getting auth context")
+	}
+
+	chatId, err := GetPathParamAsInt64(c, "id")
+	if err != nil {
+		return err
+	}
+	token := c.Param("token")
+
+	errOuter := db.Transact(ch.db, func(tx *db.Tx) error {
+		if permitted, err := checkManageWebhooks(c, tx, userPrincipalDto.UserId, chatId); !permitted {
+			return err
+		}
+
+		if deleted, err := tx.DeleteIncomingWebhook(chatId, token); err != nil {
+			return err
+		} else if !deleted {
+			return c.NoContent(http.StatusNotFound)
+		}
+		return c.NoContent(http.StatusAccepted)
+	})
+	if errOuter != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error during act transaction %v", errOuter)
+	}
+	return errOuter
+}
+
+// PostIncomingWebhookMessage writes the message on behalf of the webhook's bot, the token is the only authentication.
+// It answers like Slack does, so the existing scripts and CI integrations work unchanged
+func (mc *MessageHandler) PostIncomingWebhookMessage(c echo.Context) error {
+	token := c.Param("token")
+
+	// the unknown and the too frequent requests are refused before their bodies are read
+	hook, err := mc.db.GetIncomingWebhook(token)
+	if err != nil {
+		return err
+	}
+	if hook == nil {
+		return c.String(http.StatusNotFound, "no_service")
+	}
+
+	if delay := mc.incomingWebhookLimiter.reserve(token); delay > 0 {
+		GetLogEntry(c.Request().Context()).Infof("Incoming webhook of chat %v exceeded the rate limit", hook.ChatId)
+		c.Response().Header().Set("Retry-After", strconv.FormatInt(int64(math.Ceil(delay.Seconds())), 10))
+		return c.String(http.StatusTooManyRequests, "rate_limited")
+	}
+
+	text, err := readIncomingWebhookText(c)
+	if errors.Is(err, errIncomingWebhookPayloadTooLarge) {
+		return c.String(http.StatusRequestEntityTooLarge, "payload_too_large")
+	}
+	if err != nil {
+		GetLogEntry(c.Request().Context()).Warnf("Error during reading incoming webhook payload %v", err)
+		return c.String(http.StatusBadRequest, "invalid_payload")
+	}
+	if err := (&IncomingWebhookMessageDto{Text: text}).Validate(); err != nil {
+		return c.String(http.StatusBadRequest, "no_text")
+	}
+
+	errOuter := db.Transact(mc.db, func(tx *db.Tx) error {
+		// the Slack's text is a plain one with the escaped html special characters
+		creatableMessage := &db.Message{
+			Text:    TrimAmdSanitize(mc.policy, strings.ReplaceAll(text, "\n", "<br>")),
+			ChatId:  hook.ChatId,
+			OwnerId: db.BotOwnerId(hook.BotId),
+		}
+		if creatableMessage.Text == "" {
+			return c.String(http.StatusBadRequest, "no_text")
+		}
+		if _, err := mc.createMessage(c, tx, creatableMessage); err != nil {
+			return err
+		}
+		return c.String(http.StatusOK, "ok")
+	})
+	if errOuter != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error during act transaction %v", errOuter)
+	}
+	return errOuter
+}
@@ -0,0 +1,333 @@
+package handlers
+
+import (
+	"errors"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"nkonev.name/chat/auth"
+	"nkonev.name/chat/db"
+	"nkonev.name/chat/dto"
+	. "nkonev.name/chat/logger"
+	"nkonev.name/chat/services"
+	"nkonev.name/chat/utils"
+	"strings"
+	"time"
+)
+
+type CreateChatInviteDto struct {
+	ExpireDateTime null.Time `json:"expireDateTime"` // never expires if absent
+	MaxUses        null.Int  `json:"maxUses"`        // unlimited if absent
+}
+
+type ChatInviteDto struct {
+	Token          string    `json:"token"`
+	ChatId         int64     `json:"chatId"`
+	CreatorId      int64     `json:"creatorId"`
+	CreateDateTime time.Time `json:"createDateTime"`
+	ExpireDateTime null.Time `json:"expireDateTime"`
+	MaxUses        null.Int  `json:"maxUses"`
+	Uses           int64     `json:"uses"`
+}
+
+type PublicChatsWrapper struct {
+	Data  []*dto.PublicChatDto `json:"data"`
+	Count int64                `json:"totalCount"`
+}
+
+func (a *CreateChatInviteDto) Validate() error {
+	return validation.ValidateStruct(a,
+		validation.Field(&a.ExpireDateTime, validation.Min(time.Now()).Error("must be in the future")),
+		validation.Field(&a.MaxUses, validation.Min(int64(1))),
+	)
+}
+
+func convertToChatInviteDto(invite *db.ChatInvite) *ChatInviteDto {
+	return &ChatInviteDto{
+		Token:          invite.Token,
+		ChatId:         invite.ChatId,
+		CreatorId:      invite.CreatorId,
+		CreateDateTime: invite.CreateDateTime,
+		ExpireDateTime: invite.ExpireDateTime,
+		MaxUses:        invite.MaxUses,
+		Uses:           invite.Uses,
+	}
+}
+
+// CreateChatInvite makes the token for joining the public or the link chat
+func (ch *ChatHandler) CreateChatInvite(c echo.Context) error {
+	var bindTo = new(CreateChatInviteDto)
+	if err := c.Bind(bindTo); err != nil {
+		GetLogEntry(c.Request().Context()).Warnf("Error during binding to dto %v", err)
+		return err
+	}
+	if valid, err := ValidateAndRespondError(c, bindTo); err != nil || !valid {
+		return err
+	}
+
+	var userPrincipalDto, ok = c.Get(utils.USER_PRINCIPAL_DTO).(*auth.AuthResult)
+	if !ok {
+		GetLogEntry(c.Request().Context()).Errorf("Error during getting auth context")
+		return errors.New("Error during getting auth context")
+	}
+
+	chatId, err := GetPathParamAsInt64(c, "id")
+	if err != nil {
+		return err
+	}
+
+	errOuter := db.Transact(ch.db, func(tx *db.Tx) error {
+		if permitted, err := hasPermission(tx, userPrincipalDto.UserId, chatId, dto.PermissionManageParticipants); err != nil {
+			return err
+		} else if !permitted {
+			return c.JSON(http.StatusUnauthorized, &utils.H{"message": "You have no access to this chat"})
+		}
+
+		visibility, err := tx.GetChatVisibility(chatId)
+		if err != nil {
+			return err
+		}
+		if visibility == db.ChatVisibilityPrivate {
+			return c.JSON(http.StatusBadRequest, &utils.H{"message": "Private chat can't have invites"})
+		}
+
+		invite := &db.ChatInvite{
+			Token:          strings.ReplaceAll(uuid.New().String(), "-", ""),
+			ChatId:         chatId,
+			CreatorId:      userPrincipalDto.UserId,
+			ExpireDateTime: bindTo.ExpireDateTime,
+			MaxUses:        bindTo.MaxUses,
+		}
+		if invite.ExpireDateTime.Valid {
+			invite.ExpireDateTime = null.TimeFrom(invite.ExpireDateTime.Time.UTC())
+		}
+		if err := tx.CreateChatInvite(invite); err != nil {
+			return err
+		}
+		return c.JSON(http.StatusCreated, convertToChatInviteDto(invite))
+	})
+	if errOuter != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error during act transaction %v", errOuter)
+	}
+	return errOuter
+}
+
+func (ch *ChatHandler) GetChatInvites(c echo.Context) error {
+	var userPrincipalDto, ok = c.Get(utils.USER_PRINCIPAL_DTO).(*auth.AuthResult)
+	if !ok {
+		GetLogEntry(c.Request().Context()).Errorf("Error during getting auth context")
+		return errors.New("Error during getting auth context")
+	}
+
+	chatId, err := GetPathParamAsInt64(c, "id")
+	if err != nil {
+		return err
+	}
+
+	if permitted, err := hasPermission(&ch.db, userPrincipalDto.UserId, chatId, dto.PermissionManageParticipants); err != nil {
+		return err
+	} else if !permitted {
+		return c.JSON(http.StatusUnauthorized, &utils.H{"message": "You have no access to this chat"})
+	}
+
+	invites, err := ch.db.GetChatInvites(chatId)
+	if err != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error get chat invites from db %v", err)
+		return err
+	}
+	inviteDtos := make([]*ChatInviteDto, 0)
+	for _, invite := range invites {
+		inviteDtos = append(inviteDtos, convertToChatInviteDto(invite))
+	}
+	return c.JSON(http.StatusOK, inviteDtos)
+}
+
+func (ch *ChatHandler) DeleteChatInvite(c echo.Context) error {
+	var userPrincipalDto, ok = c.Get(utils.USER_PRINCIPAL_DTO).(*auth.AuthResult)
+	if !ok {
+		GetLogEntry(c.Request().Context()).Errorf("Error during getting auth context")
+		return errors.New("Error during getting auth context")
+	}
+
+	chatId, err := GetPathParamAsInt64(c, "id")
+	if err != nil {
+		return err
+	}
+	token := c.Param("token")
+
+	errOuter := db.Transact(ch.db, func(tx *db.Tx) error {
+		if permitted, err := hasPermission(tx, userPrincipalDto.UserId, chatId, dto.PermissionManageParticipants); err != nil {
+			return err
+		} else if !permitted {
+			return c.JSON(http.StatusUnauthorized, &utils.H{"message": "You have no access to this chat"})
+		}
+
+		if deleted, err := tx.DeleteChatInvite(chatId, token); err != nil {
+			return err
+		} else if !deleted {
+			return c.NoContent(http.StatusNotFound)
+		}
+		return c.NoContent(http.StatusAccepted)
+	})
+	if errOuter != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error during act transaction %v", errOuter)
+	}
+	return errOuter
+}
+
+// JoinChatByInvite adds the current user to the public or the link chat by the invite token
+func (ch *ChatHandler) JoinChatByInvite(c echo.Context) error {
+	var userPrincipalDto, ok = c.Get(utils.USER_PRINCIPAL_DTO).(*auth.AuthResult)
+	if !ok {
+		GetLogEntry(c.Request().Context()).Errorf("Error during getting auth context")
+		return errors.New("Error during getting auth context")
+	}
+
+	token := c.Param("token")
+
+	errOuter := db.Transact(ch.db, func(tx *db.Tx) error {
+		invite, err := tx.GetChatInvite(token)
+		if err != nil {
+			return err
+		}
+		if invite == nil {
+			return c.JSON(http.StatusNotFound, &utils.H{"message": "Invite is not found or expired"})
+		}
+
+		visibility, err := tx.GetChatVisibility(invite.ChatId)
+		if err != nil {
+			return err
+		}
+		// the empty visibility is of the deleted chat
+		if visibility == db.ChatVisibilityPrivate || visibility == "" {
+			return c.JSON(http.StatusNotFound, &utils.H{"message": "Invite is not found or expired"})
+		}
+
+		if participant, err := tx.IsParticipant(userPrincipalDto.UserId, invite.ChatId); err != nil {
+			return err
+		} else if participant {
+			// the repeated click on the link doesn't take the use
+			return ch.respondChat(c, tx, invite.ChatId, userPrincipalDto)
+		}
+
+		if used, err := tx.UseChatInvite(token); err != nil {
+			return err
+		} else if !used {
+			return c.JSON(http.StatusNotFound, &utils.H{"message": "Invite is not found or expired"})
+		}
+
+		return ch.joinChat(c, tx, invite.ChatId, userPrincipalDto)
+	})
+	if errOuter != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error during act transaction %v", errOuter)
+	}
+	return errOuter
+}
+
+// JoinPublicChat adds the current user to the public chat, the other chats require an invite
+func (ch *ChatHandler) JoinPublicChat(c echo.Context) error {
+	var userPrincipalDto, ok = c.Get(utils.USER_PRINCIPAL_DTO).(*auth.AuthResult)
+	if !ok {
+		GetLogEntry(c.Request().Context()).Errorf("Error during getting auth context")
+		return errors.New("Error during getting auth context")
+	}
+
+	chatId, err := GetPathParamAsInt64(c, "id")
+	if err != nil {
+		return err
+	}
+
+	errOuter := db.Transact(ch.db, func(tx *db.Tx) error {
+		if participant, err := tx.IsParticipant(userPrincipalDto.UserId, chatId); err != nil {
+			return err
+		} else if participant {
+			return ch.respondChat(c, tx, chatId, userPrincipalDto)
+		}
+
+		visibility, err := tx.GetChatVisibility(chatId)
+		if err != nil {
+			return err
+		}
+		if visibility != db.ChatVisibilityPublic {
+			return c.NoContent(http.StatusNotFound)
+		}
+
+		return ch.joinChat(c, tx, chatId, userPrincipalDto)
+	})
+	if errOuter != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error during act transaction %v", errOuter)
+	}
+	return errOuter
+}
+
+func (ch *ChatHandler) respondChat(c echo.Context, tx *db.Tx, chatId int64, userPrincipalDto *auth.AuthResult) error {
+	chatDto, err := getChat(tx, ch.restClient, c, chatId, userPrincipalDto.UserId, userPrincipalDto, 0, 0)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, chatDto)
+}
+
+// joinChat adds the user as an ordinary participant and notifies the participants the same way as AddParticipants does
+func (ch *ChatHandler) joinChat(c echo.Context, tx *db.Tx, chatId int64, userPrincipalDto *auth.AuthResult) error {
+	if err := tx.AddParticipant(userPrincipalDto.UserId, chatId, dto.RoleMember); err != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error during adding participant in database %v", err)
+		return err
+	}
+
+	participantIds, err := tx.GetAllParticipantIds(chatId)
+	if err != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error during getting chat participants %v", err)
+		return err
+	}
+
+	chatDto, err := getChat(tx, ch.restClient, c, chatId, userPrincipalDto.UserId, userPrincipalDto, 0, 0)
+	if err != nil {
+		return err
+	}
+	copiedChat, err := ch.getChatWithAdminedUsers(c, chatDto, tx)
+	if err != nil {
+		return c.NoContent(http.StatusInternalServerError)
+	}
+	ch.notificator.NotifyAboutChangeChat(c, copiedChat, participantIds, services.NoPagePlaceholder, tx)
+
+	return c.JSON(http.StatusOK, chatDto)
+}
+
+// GetPublicChats is the directory of the public chats, it is available without authentication
+func (ch *ChatHandler) GetPublicChats(c echo.Context) error {
+	page := utils.FixPageString(c.QueryParam("page"))
+	size := utils.FixSizeString(c.QueryParam("size"))
+	offset := utils.GetOffset(page, size)
+
+	searchString := strings.TrimSpace(c.QueryParam("searchString"))
+	if searchString != "" {
+		searchString = TrimAmdSanitize(ch.policy, searchString)
+	}
+
+	chats, err := ch.db.GetPublicChats(size, offset, searchString)
+	if err != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error get public chats from db %v", err)
+		return err
+	}
+	count, err := ch.db.CountPublicChats(searchString)
+	if err != nil {
+		return err
+	}
+
+	chatDtos := make([]*dto.PublicChatDto, 0)
+	for _, cc := range chats {
+		chatDtos = append(chatDtos, &dto.PublicChatDto{
+			Id:                 cc.Id,
+			Name:               cc.Title,
+			Avatar:             cc.Avatar,
+			AvatarBig:          cc.AvatarBig,
+			LastUpdateDateTime: cc.LastUpdateDateTime,
+			ParticipantsCount:  cc.ParticipantsCount,
+			IsChannel:          cc.Channel,
+		})
+	}
+	return c.JSON(http.StatusOK, PublicChatsWrapper{Data: chatDtos, Count: count})
+}
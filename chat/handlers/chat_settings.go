@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"errors"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"nkonev.name/chat/auth"
+	"nkonev.name/chat/db"
+	"nkonev.name/chat/dto"
+	. "nkonev.name/chat/logger"
+	"nkonev.name/chat/services"
+	"nkonev.name/chat/utils"
+)
+
+func (ch *ChatHandler) GetChatSettings(c echo.Context) error {
+	var userPrincipalDto, ok = c.Get(utils.USER_PRINCIPAL_DTO).(*auth.AuthResult)
+	if !ok {
+		GetLogEntry(c.Request().Context()).Errorf("Error during getting auth context")
+		return errors.New("Error during getting auth context")
+	}
+
+	chatId, err := GetPathParamAsInt64(c, "id")
+	if err != nil {
+		return err
+	}
+
+	settings, err := ch.db.GetChatSettings(userPrincipalDto.UserId, chatId)
+	if err != nil {
+		return err
+	}
+	if settings == nil {
+		return c.JSON(http.StatusUnauthorized, &utils.H{"message": "You have no access to this chat"})
+	}
+	return c.JSON(http.StatusOK, &dto.ChatSettingsDto{Muted: settings.Muted, Archived: settings.Archived})
+}
+
+// PutChatSettings changes the settings only for the current user, the other participants don't see them
+func (ch *ChatHandler) PutChatSettings(c echo.Context) error {
+	var bindTo = new(dto.ChatSettingsDto)
+	if err := c.Bind(bindTo); err != nil {
+		GetLogEntry(c.Request().Context()).Warnf("Error during binding to dto %v", err)
+		return err
+	}
+
+	var userPrincipalDto, ok = c.Get(utils.USER_PRINCIPAL_DTO).(*auth.AuthResult)
+	if !ok {
+		GetLogEntry(c.Request().Context()).Errorf("Error during getting auth context")
+		return errors.New("Error during getting auth context")
+	}
+
+	chatId, err := GetPathParamAsInt64(c, "id")
+	if err != nil {
+		return err
+	}
+
+	errOuter := db.Transact(ch.db, func(tx *db.Tx) error {
+		if participant, err := tx.IsParticipant(userPrincipalDto.UserId, chatId); err != nil {
+			return err
+		} else if !participant {
+			return c.JSON(http.StatusUnauthorized, &utils.H{"message": "You have no access to this chat"})
+		}
+
+		if err := tx.SetChatSettings(userPrincipalDto.UserId, chatId, &db.ChatSettings{Muted: bindTo.Muted, Archived: bindTo.Archived}); err != nil {
+			return err
+		}
+
+		// muting changes the global badge
+		ch.notificator.ChatNotifyAllUnreadMessageCount([]int64{userPrincipalDto.UserId}, c, tx)
+		return c.JSON(http.StatusOK, bindTo)
+	})
+	if errOuter != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error during act transaction %v", errOuter)
+	}
+	return errOuter
+}
+
+// PinChat puts the chat to the top of the list only for the current user
+func (ch *ChatHandler) PinChat(c echo.Context) error {
+	var userPrincipalDto, ok = c.Get(utils.USER_PRINCIPAL_DTO).(*auth.AuthResult)
+	if !ok {
+		GetLogEntry(c.Request().Context()).Errorf("Error during getting auth context")
+		return errors.New("Error during getting auth context")
+	}
+
+	chatId, err := GetPathParamAsInt64(c, "id")
+	if err != nil {
+		return err
+	}
+
+	pin, err := GetQueryParamAsBoolean(c, "pin")
+	if err != nil {
+		return err
+	}
+
+	errOuter := db.Transact(ch.db, func(tx *db.Tx) error {
+		if participant, err := tx.IsParticipant(userPrincipalDto.UserId, chatId); err != nil {
+			return err
+		} else if !participant {
+			return c.JSON(http.StatusUnauthorized, &utils.H{"message": "You have no access to this chat"})
+		}
+
+		if err := tx.PinChat(userPrincipalDto.UserId, chatId, pin); err != nil {
+			return err
+		}
+
+		responseDto, err := getChat(tx, ch.restClient, c, chatId, userPrincipalDto.UserId, userPrincipalDto, 0, 0)
+		if err != nil {
+			return err
+		}
+		copiedChat, err := ch.getChatWithAdminedUsers(c, responseDto, tx)
+		if err != nil {
+			return err
+		}
+		// the other participants' lists aren't changed
+		ch.notificator.NotifyAboutChangeChat(c, copiedChat, []int64{userPrincipalDto.UserId}, services.NoPagePlaceholder, tx)
+		return c.JSON(http.StatusOK, responseDto)
+	})
+	if errOuter != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error during act transaction %v", errOuter)
+	}
+	return errOuter
+}
@@ -0,0 +1,265 @@
+package handlers
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/guregu/null"
+	"github.com/labstack/echo/v4"
+	"github.com/spf13/viper"
+	"net/http"
+	"net/url"
+	"nkonev.name/chat/auth"
+	"nkonev.name/chat/db"
+	"nkonev.name/chat/dto"
+	. "nkonev.name/chat/logger"
+	"nkonev.name/chat/services"
+	"nkonev.name/chat/utils"
+	"time"
+)
+
+type CreateChatWebhookDto struct {
+	Url        string      `json:"url"`
+	Secret     null.String `json:"secret"`     // generated if absent
+	EventTypes []string    `json:"eventTypes"` // all the events if empty, e.g. ["message_created", "chat_edited"]
+}
+
+type ChatWebhookDto struct {
+	Id             int64       `json:"id"`
+	ChatId         int64       `json:"chatId"`
+	Url            string      `json:"url"`
+	Secret         null.String `json:"secret,omitempty"` // is shown only once, after creation
+	EventTypes     []string    `json:"eventTypes"`
+	CreatorId      int64       `json:"creatorId"`
+	CreateDateTime time.Time   `json:"createDateTime"`
+}
+
+type ChatWebhookDeliveryDto struct {
+	Id             int64       `json:"id"`
+	EventType      string      `json:"eventType"`
+	Attempt        int         `json:"attempt"`
+	StatusCode     null.Int    `json:"statusCode"`
+	Error          null.String `json:"error"`
+	Success        bool        `json:"success"`
+	CreateDateTime time.Time   `json:"createDateTime"`
+}
+
+var webhookUrlRule = validation.By(func(value interface{}) error {
+	parsed, err := url.Parse(value.(string))
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return errors.New("must be a http or https url")
+	}
+	// the internal services would be reachable with the deliveries log as the response
+	if !viper.GetBool("webhooks.allowPrivateAddresses") {
+		if err := services.CheckPublicHost(parsed.Hostname()); err != nil {
+			return errors.New("must be a public host")
+		}
+	}
+	return nil
+})
+
+func (a *CreateChatWebhookDto) Validate() error {
+	return validation.ValidateStruct(a,
+		validation.Field(&a.Url, validation.Required, validation.Length(1, 2048), webhookUrlRule),
+		validation.Field(&a.Secret, validation.Length(16, 256)),
+		validation.Field(&a.EventTypes, validation.Each(validation.Required, validation.Length(1, 64))),
+	)
+}
+
+func convertToChatWebhookDto(hook *db.ChatWebhook) *ChatWebhookDto {
+	return &ChatWebhookDto{
+		Id:             hook.Id,
+		ChatId:         hook.ChatId,
+		Url:            hook.Url,
+		EventTypes:     hook.EventTypes,
+		CreatorId:      hook.CreatorId,
+		CreateDateTime: hook.CreateDateTime,
+	}
+}
+
+func generateWebhookSecret() (string, error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bytes), nil
+}
+
+// checkManageWebhooks responds 401 and returns false if the user can't manage the webhooks of the chat
+func checkManageWebhooks(c echo.Context, co db.CommonOperations, userId, chatId int64) (bool, error) {
+	if permitted, err := hasPermission(co, userId, chatId, dto.PermissionManageWebhooks); err != nil {
+		return false, err
+	} else if !permitted {
+		return false, c.JSON(http.StatusUnauthorized, &utils.H{"message": "You have no access to this chat"})
+	}
+	return true, nil
+}
+
+func (ch *ChatHandler) CreateChatWebhook(c echo.Context) error {
+	var bindTo = new(CreateChatWebhookDto)
+	if err := c.Bind(bindTo); err != nil {
+		GetLogEntry(c.Request().Context()).Warnf("Error during binding to dto %v", err)
+		return err
+	}
+	if valid, err := ValidateAndRespondError(c, bindTo); err != nil || !valid {
+		return err
+	}
+
+	var userPrincipalDto, ok = c.Get(utils.USER_PRINCIPAL_DTO).(*auth.AuthResult)
+	if !ok {
+		GetLogEntry(c.Request().Context()).Errorf("Error during getting auth context")
+		return errors.New("Error during getting auth context")
+	}
+
+	chatId, err := GetPathParamAsInt64(c, "id")
+	if err != nil {
+		return err
+	}
+
+	errOuter := db.Transact(ch.db, func(tx *db.Tx) error {
+		if permitted, err := checkManageWebhooks(c, tx, userPrincipalDto.UserId, chatId); !permitted {
+			return err
+		}
+
+		secret := bindTo.Secret.String
+		if !bindTo.Secret.Valid {
+			if secret, err = generateWebhookSecret(); err != nil {
+				return err
+			}
+		}
+		eventTypes := bindTo.EventTypes
+		if eventTypes == nil {
+			eventTypes = make([]string, 0)
+		}
+		hook := &db.ChatWebhook{
+			ChatId:     chatId,
+			Url:        bindTo.Url,
+			Secret:     secret,
+			EventTypes: eventTypes,
+			CreatorId:  userPrincipalDto.UserId,
+		}
+		if err := tx.CreateChatWebhook(hook); err != nil {
+			return err
+		}
+		hookDto := convertToChatWebhookDto(hook)
+		hookDto.Secret = null.StringFrom(secret)
+		return c.JSON(http.StatusCreated, hookDto)
+	})
+	if errOuter != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error during act transaction %v", errOuter)
+	}
+	return errOuter
+}
+
+func (ch *ChatHandler) GetChatWebhooks(c echo.Context) error {
+	var userPrincipalDto, ok = c.Get(utils.USER_PRINCIPAL_DTO).(*auth.AuthResult)
+	if !ok {
+		GetLogEntry(c.Request().Context()).Errorf("Error during getting auth context")
+		return errors.New("Error during getting auth context")
+	}
+
+	chatId, err := GetPathParamAsInt64(c, "id")
+	if err != nil {
+		return err
+	}
+
+	if permitted, err := checkManageWebhooks(c, &ch.db, userPrincipalDto.UserId, chatId); !permitted {
+		return err
+	}
+
+	hooks, err := ch.db.GetChatWebhooks(chatId)
+	if err != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error get chat webhooks from db %v", err)
+		return err
+	}
+	hookDtos := make([]*ChatWebhookDto, 0)
+	for _, hook := range hooks {
+		hookDtos = append(hookDtos, convertToChatWebhookDto(hook))
+	}
+	return c.JSON(http.StatusOK, hookDtos)
+}
+
+func (ch *ChatHandler) DeleteChatWebhook(c echo.Context) error {
+	var userPrincipalDto, ok = c.Get(utils.USER_PRINCIPAL_DTO).(*auth.AuthResult)
+	if !ok {
+		GetLogEntry(c.Request().Context()).Errorf("Error during getting auth context")
+		return errors.New("Error during getting auth context")
+	}
+
+	chatId, err := GetPathParamAsInt64(c, "id")
+	if err != nil {
+		return err
+	}
+	hookId, err := GetPathParamAsInt64(c, "hookId")
+	if err != nil {
+		return err
+	}
+
+	errOuter := db.Transact(ch.db, func(tx *db.Tx) error {
+		if permitted, err := checkManageWebhooks(c, tx, userPrincipalDto.UserId, chatId); !permitted {
+			return err
+		}
+
+		if deleted, err := tx.DeleteChatWebhook(chatId, hookId); err != nil {
+			return err
+		} else if !deleted {
+			return c.NoContent(http.StatusNotFound)
+		}
+		return c.NoContent(http.StatusAccepted)
+	})
+	if errOuter != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error during act transaction %v", errOuter)
+	}
+	return errOuter
+}
+
+// GetChatWebhookDeliveries returns the log of the delivery attempts, the newest first
+func (ch *ChatHandler) GetChatWebhookDeliveries(c echo.Context) error {
+	var userPrincipalDto, ok = c.Get(utils.USER_PRINCIPAL_DTO).(*auth.AuthResult)
+	if !ok {
+		GetLogEntry(c.Request().Context()).Errorf("Error during getting auth context")
+		return errors.New("Error during getting auth context")
+	}
+
+	chatId, err := GetPathParamAsInt64(c, "id")
+	if err != nil {
+		return err
+	}
+	hookId, err := GetPathParamAsInt64(c, "hookId")
+	if err != nil {
+		return err
+	}
+
+	page := utils.FixPageString(c.QueryParam("page"))
+	size := utils.FixSizeString(c.QueryParam("size"))
+	offset := utils.GetOffset(page, size)
+
+	if permitted, err := checkManageWebhooks(c, &ch.db, userPrincipalDto.UserId, chatId); !permitted {
+		return err
+	}
+	if hook, err := ch.db.GetChatWebhook(chatId, hookId); err != nil {
+		return err
+	} else if hook == nil {
+		return c.NoContent(http.StatusNotFound)
+	}
+
+	deliveries, err := ch.db.GetChatWebhookDeliveries(hookId, size, offset)
+	if err != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error get chat webhook deliveries from db %v", err)
+		return err
+	}
+	deliveryDtos := make([]*ChatWebhookDeliveryDto, 0)
+	for _, delivery := range deliveries {
+		deliveryDtos = append(deliveryDtos, &ChatWebhookDeliveryDto{
+			Id:             delivery.Id,
+			EventType:      delivery.EventType,
+			Attempt:        delivery.Attempt,
+			StatusCode:     delivery.StatusCode,
+			Error:          delivery.Error,
+			Success:        delivery.Success,
+			CreateDateTime: delivery.CreateDateTime,
+		})
+	}
+	return c.JSON(http.StatusOK, deliveryDtos)
+}
@@ -2,7 +2,9 @@ package handlers
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"github.com/araddon/dateparse"
 	"github.com/labstack/echo/v4"
 	"github.com/microcosm-cc/bluemonday"
@@ -10,6 +12,7 @@ import (
 	"net/http"
 	"nkonev.name/chat/auth"
 	"nkonev.name/chat/client"
+	"nkonev.name/chat/db"
 	"nkonev.name/chat/dto"
 	. "nkonev.name/chat/logger"
 	"nkonev.name/chat/utils"
@@ -44,6 +47,32 @@ func getUsersRemotelyOrEmpty(userIdSet map[int64]bool, restClient client.RestCli
 	}
 }
 
+// getOwnersOrEmpty is getUsersRemotelyOrEmpty for the message owners and the participants, which can be the bots as well
+func getOwnersOrEmpty(co db.CommonOperations, ownerIdSet map[int64]bool, restClient client.RestClient, c echo.Context) map[int64]*dto.User {
+	var userIdSet = map[int64]bool{}
+	var botOwnerIds = []int64{}
+	for ownerId := range ownerIdSet {
+		if db.IsBotOwnerId(ownerId) {
+			botOwnerIds = append(botOwnerIds, ownerId)
+		} else {
+			userIdSet[ownerId] = true
+		}
+	}
+	var owners = getUsersRemotelyOrEmpty(userIdSet, restClient, c)
+	if len(botOwnerIds) == 0 {
+		return owners
+	}
+	bots, err := co.GetBotsByOwnerIds(botOwnerIds)
+	if err != nil {
+		GetLogEntry(c.Request().Context()).Warnf("Error during getting bots %v", err)
+		return owners
+	}
+	for ownerId, bot := range bots {
+		owners[ownerId] = &dto.User{Id: ownerId, Login: bot.Name, Avatar: bot.Avatar}
+	}
+	return owners
+}
+
 type AuthMiddleware echo.MiddlewareFunc
 
 func ExtractAuth(request *http.Request) (*auth.AuthResult, error) {
@@ -89,12 +118,16 @@ func ExtractAuth(request *http.Request) (*auth.AuthResult, error) {
 //   - *AuthResult pointer or nil
 //   - is whitelisted
 //   - error
-func authorize(request *http.Request) (*auth.AuthResult, bool, error) {
+func authorize(request *http.Request, dbR db.DB) (*auth.AuthResult, bool, error) {
 	whitelistStr := viper.GetStringSlice("auth.exclude")
 	whitelist := utils.StringsToRegexpArray(whitelistStr)
 	if utils.CheckUrlInWhitelist(whitelist, request.RequestURI) {
 		return nil, true, nil
 	}
+	if token, ok := extractBotToken(request); ok {
+		auth, err := authorizeBot(request, dbR, token)
+		return auth, false, err
+	}
 	auth, err := ExtractAuth(request)
 	if err != nil {
 		GetLogEntry(request.Context()).Infof("Error during extract AuthResult: %v", err)
@@ -104,10 +137,47 @@ func authorize(request *http.Request) (*auth.AuthResult, bool, error) {
 	return auth, false, nil
 }
 
-func ConfigureAuthMiddleware() AuthMiddleware {
+const botAuthorizationPrefix = "Bot "
+
+// HashBotToken is stored instead of the bot's api token
+func HashBotToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+func extractBotToken(request *http.Request) (string, bool) {
+	header := request.Header.Get(echo.HeaderAuthorization)
+	if !strings.HasPrefix(header, botAuthorizationPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(header, botAuthorizationPrefix), true
+}
+
+// authorizeBot checks the bot's api token instead of the headers of aaa, the bot can call only the endpoints from auth.bots.allowed.
+// Returns nil if the token is unknown or the endpoint isn't allowed
+func authorizeBot(request *http.Request, dbR db.DB, token string) (*auth.AuthResult, error) {
+	allowed := utils.StringsToRegexpArray(viper.GetStringSlice("auth.bots.allowed"))
+	if !utils.CheckUrlInWhitelist(allowed, request.RequestURI) {
+		GetLogEntry(request.Context()).Infof("Bots aren't allowed to call %v", request.RequestURI)
+		return nil, nil
+	}
+	bot, err := dbR.GetBotByTokenHash(HashBotToken(token))
+	if err != nil || bot == nil {
+		return nil, err
+	}
+	auth := &auth.AuthResult{
+		UserId:    db.BotOwnerId(bot.Id),
+		UserLogin: bot.Name,
+		Roles:     []string{},
+	}
+	GetLogEntry(request.Context()).Infof("Success bot AuthResult: %v", *auth)
+	return auth, nil
+}
+
+func ConfigureAuthMiddleware(dbR db.DB) AuthMiddleware {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
-			authResult, whitelist, err := authorize(c.Request())
+			authResult, whitelist, err := authorize(c.Request(), dbR)
 			if err != nil {
 				Logger.Errorf("Error during authorize: %v", err)
 				return err
@@ -144,3 +214,25 @@ func Trim(str string) string {
 func TrimAmdSanitize(policy *bluemonday.Policy, input string) string {
 	return Trim(SanitizeMessage(policy, input))
 }
+
+// hasPermission checks the participant's role against the permission matrix, the non-participant has no permissions
+func hasPermission(co db.CommonOperations, userId, chatId int64, permission dto.Permission) (bool, error) {
+	role, err := co.GetParticipantRole(userId, chatId)
+	if err != nil {
+		return false, err
+	}
+	return dto.HasPermission(role, permission), nil
+}
+
+// canWriteMessage is hasPermission for writing, which also takes into account is the chat a channel
+func canWriteMessage(co db.CommonOperations, userId, chatId int64) (bool, error) {
+	role, err := co.GetParticipantRole(userId, chatId)
+	if err != nil {
+		return false, err
+	}
+	channel, err := co.IsChatChannel(chatId)
+	if err != nil {
+		return false, err
+	}
+	return dto.CanWriteMessage(role, channel), nil
+}
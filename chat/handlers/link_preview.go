@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"context"
+	"github.com/labstack/echo/v4"
+	"github.com/spf13/viper"
+	"net/http"
+	"nkonev.name/chat/db"
+	. "nkonev.name/chat/logger"
+	"nkonev.name/chat/services"
+	"time"
+)
+
+type linkPreviewJob struct {
+	chatId    int64
+	messageId int64
+	links     []string
+}
+
+// startLinkPreviewWorkers bounds the concurrent fetches, the links are untrusted and the pages can be slow
+func (mc *MessageHandler) startLinkPreviewWorkers(workers int) {
+	for i := 0; i < workers; i++ {
+		go func() {
+			for job := range mc.linkPreviewJobs {
+				mc.processLinkPreviewJob(job)
+			}
+		}()
+	}
+}
+
+// fetchLinkPreviews queues the message's links after the transaction which saves the message is committed,
+// then the participants get message_edited with the previews which weren't cached or were stale
+func (mc *MessageHandler) fetchLinkPreviews(tx *db.Tx, chatId, messageId int64, text string) {
+	links := services.ExtractLinks(text, viper.GetInt("linkPreviews.maxPerMessage"))
+	if len(links) == 0 {
+		return
+	}
+	tx.AfterCommit(func() {
+		select {
+		case mc.linkPreviewJobs <- &linkPreviewJob{chatId: chatId, messageId: messageId, links: links}:
+		default:
+			Logger.Warnf("Skipping link previews of message %v, the queue is full", messageId)
+		}
+	})
+}
+
+func (mc *MessageHandler) processLinkPreviewJob(job *linkPreviewJob) {
+	cached, err := mc.db.GetLinkPreviews(job.links)
+	if err != nil {
+		Logger.Errorf("Error during getting cached link previews of message %v %v", job.messageId, err)
+		return
+	}
+	staleBefore := time.Now().UTC().Add(-viper.GetDuration("linkPreviews.cacheTtl"))
+	var fetched = false
+	for _, link := range job.links {
+		if preview, ok := cached[link]; ok && preview.FetchDateTime.After(staleBefore) {
+			continue
+		}
+		preview := mc.fetchLinkPreview(job.messageId, link)
+		if err := mc.db.SaveLinkPreview(preview); err != nil {
+			Logger.Errorf("Error during saving link preview of message %v %v", job.messageId, err)
+			return
+		}
+		fetched = fetched || preview.Success
+	}
+	if fetched {
+		mc.notifyAboutLinkPreviews(job.chatId, job.messageId)
+	}
+}
+
+// fetchLinkPreview returns the unsuccessful preview if the link can't be fetched, so it isn't fetched again until the cache is stale
+func (mc *MessageHandler) fetchLinkPreview(messageId int64, link string) *db.LinkPreview {
+	ctx, cancel := context.WithTimeout(context.Background(), viper.GetDuration("linkPreviews.timeout"))
+	defer cancel()
+	preview, err := mc.linkPreviewFetcher.Fetch(ctx, link)
+	if err != nil {
+		Logger.Infof("Unable to fetch link preview of message %v: %v", messageId, err)
+		return &db.LinkPreview{Url: link, Success: false}
+	}
+	return preview
+}
+
+func (mc *MessageHandler) notifyAboutLinkPreviews(chatId, messageId int64) {
+	// notifications and users fetching expect the echo context, there is no http request here
+	request, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/internal/link-preview", nil)
+	if err != nil {
+		Logger.Errorf("Error during creating context for link previews %v", err)
+		return
+	}
+	c := echo.New().NewContext(request, nil)
+
+	err = db.Transact(mc.db, func(tx *db.Tx) error {
+		participantIds, err := tx.GetAllParticipantIds(chatId)
+		if err != nil {
+			return err
+		}
+		if len(participantIds) == 0 {
+			return nil
+		}
+		// the owner could have left or be a bot, so the message is taken on behalf of any participant,
+		// the personalized fields are set for every participant during the notification anyway
+		message, err := getMessage(c, tx, mc.restClient, chatId, messageId, participantIds[0])
+		if err != nil {
+			return err
+		}
+		if message == nil {
+			Logger.Infof("Message %v has been deleted before its link previews", messageId)
+			return nil
+		}
+		mc.notificator.NotifyAboutEditMessage(c, participantIds, chatId, message)
+		return nil
+	})
+	if err != nil {
+		Logger.Errorf("Error during notifying about link previews of message %v %v", messageId, err)
+	}
+}
@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"errors"
+	strip "github.com/grokify/html-strip-tags-go"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"nkonev.name/chat/auth"
+	"nkonev.name/chat/db"
+	"nkonev.name/chat/dto"
+	. "nkonev.name/chat/logger"
+	"nkonev.name/chat/utils"
+	"regexp"
+	"strings"
+)
+
+// @login at the beginning or after a non-login character, so e-mails aren't taken as mentions
+var mentionRegexp = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_.@-])@([\p{L}\p{N}_.-]+)`)
+
+// returns the lowercased distinct logins mentioned in the message
+func findMentionedLogins(html string) []string {
+	var ret = []string{}
+	var set = map[string]bool{}
+	for _, match := range mentionRegexp.FindAllStringSubmatch(strip.StripTags(html), -1) {
+		// a dot or a dash at the end is rather a punctuation
+		login := strings.ToLower(strings.TrimRight(match[1], ".-"))
+		if login == "" || set[login] {
+			continue
+		}
+		set[login] = true
+		ret = append(ret, login)
+	}
+	return ret
+}
+
+// processMentions stores the participants mentioned in the message and notifies the newly mentioned ones
+func (mc *MessageHandler) processMentions(c echo.Context, tx *db.Tx, message *dto.DisplayMessageDto, participantIds []int64) error {
+	var mentionedIds = []int64{}
+	logins := findMentionedLogins(message.Text)
+	if len(logins) > 0 {
+		users, err := mc.restClient.GetUsersByLogins(logins, c.Request().Context())
+		if err != nil {
+			// we don't want to lose the existing mentions because of unavailable aaa
+			GetLogEntry(c.Request().Context()).Warnf("Error during getting users from aaa, skipping mentions %v", err)
+			return nil
+		}
+		var participantsSet = map[int64]bool{}
+		for _, participantId := range participantIds {
+			participantsSet[participantId] = true
+		}
+		var mentionedLogins = map[string]bool{}
+		for _, login := range logins {
+			mentionedLogins[login] = true
+		}
+		for _, user := range users {
+			if participantsSet[user.Id] && mentionedLogins[strings.ToLower(user.Login)] && user.Id != message.OwnerId {
+				mentionedIds = append(mentionedIds, user.Id)
+			}
+		}
+	}
+
+	added, err := tx.SetMessageMentions(message.ChatId, message.Id, mentionedIds)
+	if err != nil {
+		return err
+	}
+	if len(added) > 0 {
+		mc.notificator.NotifyAboutMention(c, added, &dto.MentionDto{
+			ChatId:         message.ChatId,
+			MessageId:      message.Id,
+			OwnerId:        message.OwnerId,
+			Owner:          message.Owner,
+			Text:           shortenText(message.Text),
+			CreateDateTime: message.CreateDateTime,
+		})
+	}
+	return nil
+}
+
+// GetMentions returns the unread mentions of the current user over all the chats
+func (mc *MessageHandler) GetMentions(c echo.Context) error {
+	var userPrincipalDto, ok = c.Get(utils.USER_PRINCIPAL_DTO).(*auth.AuthResult)
+	if !ok {
+		GetLogEntry(c.Request().Context()).Errorf("Error during getting auth context")
+		return errors.New("Error during getting auth context")
+	}
+
+	page := utils.FixPageString(c.QueryParam("page"))
+	size := utils.FixSizeString(c.QueryParam("size"))
+	offset := utils.GetOffset(page, size)
+
+	mentions, err := mc.db.GetUnreadMentions(userPrincipalDto.UserId, size, offset)
+	if err != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error get mentions from db %v", err)
+		return err
+	}
+
+	var ownersSet = map[int64]bool{}
+	for _, mention := range mentions {
+		ownersSet[mention.OwnerId] = true
+	}
+	var owners = getOwnersOrEmpty(&mc.db, ownersSet, mc.restClient, c)
+
+	mentionDtos := make([]*dto.MentionDto, 0)
+	for _, mention := range mentions {
+		mentionDtos = append(mentionDtos, &dto.MentionDto{
+			ChatId:         mention.ChatId,
+			MessageId:      mention.MessageId,
+			OwnerId:        mention.OwnerId,
+			Owner:          getOwnerOrStub(owners, mention.OwnerId),
+			Text:           shortenText(mention.Text),
+			CreateDateTime: mention.CreateDateTime,
+		})
+	}
+	return c.JSON(http.StatusOK, mentionDtos)
+}
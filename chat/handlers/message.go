@@ -9,6 +9,7 @@ import (
 	"github.com/guregu/null"
 	"github.com/labstack/echo/v4"
 	"github.com/microcosm-cc/bluemonday"
+	"github.com/spf13/viper"
 	"math"
 	"net/http"
 	"nkonev.name/chat/auth"
@@ -29,8 +30,9 @@ type EditMessageDto struct {
 }
 
 type CreateMessageDto struct {
-	Text         string     `json:"text"`
-	FileItemUuid *uuid.UUID `json:"fileItemUuid"`
+	Text             string     `json:"text"`
+	FileItemUuid     *uuid.UUID `json:"fileItemUuid"`
+	ReplyToMessageId null.Int   `json:"replyToMessageId"`
 }
 
 type MessageHandler struct {
@@ -38,12 +40,22 @@ type MessageHandler struct {
 	policy      *bluemonday.Policy
 	notificator services.Notifications
 	restClient  client.RestClient
+	chatHandler *ChatHandler // the slash commands reuse its operations
+
+	incomingWebhookLimiter *tokenRateLimiter
+	linkPreviewFetcher     *services.LinkPreviewFetcher
+	linkPreviewJobs        chan *linkPreviewJob
 }
 
-func NewMessageHandler(dbR db.DB, policy *bluemonday.Policy, notificator services.Notifications, restClient client.RestClient) *MessageHandler {
-	return &MessageHandler{
-		db: dbR, policy: policy, notificator: notificator, restClient: restClient,
+func NewMessageHandler(dbR db.DB, policy *bluemonday.Policy, notificator services.Notifications, restClient client.RestClient, chatHandler *ChatHandler, linkPreviewFetcher *services.LinkPreviewFetcher) *MessageHandler {
+	mc := &MessageHandler{
+		db: dbR, policy: policy, notificator: notificator, restClient: restClient, chatHandler: chatHandler,
+		linkPreviewFetcher:     linkPreviewFetcher,
+		linkPreviewJobs:        make(chan *linkPreviewJob, viper.GetInt("linkPreviews.queueSize")),
+		incomingWebhookLimiter: newTokenRateLimiter(viper.GetInt("incomingWebhooks.rateLimit.requests"), viper.GetDuration("incomingWebhooks.rateLimit.period")),
 	}
+	mc.startLinkPreviewWorkers(viper.GetInt("linkPreviews.workers"))
+	return mc
 }
 
 func (mc *MessageHandler) GetMessages(c echo.Context) error {
@@ -79,14 +91,9 @@ func (mc *MessageHandler) GetMessages(c echo.Context) error {
 		GetLogEntry(c.Request().Context()).Errorf("Error get messages from db %v", err)
 		return err
 	} else {
-		var ownersSet = map[int64]bool{}
-		for _, c := range messages {
-			ownersSet[c.OwnerId] = true
-		}
-		var owners = getUsersRemotelyOrEmpty(ownersSet, mc.restClient, c)
-		messageDtos := make([]*dto.DisplayMessageDto, 0)
-		for _, c := range messages {
-			messageDtos = append(messageDtos, convertToMessageDto(c, owners, userPrincipalDto.UserId))
+		messageDtos, err := convertToMessageDtos(c, &mc.db, mc.restClient, chatId, messages, userPrincipalDto.UserId)
+		if err != nil {
+			return err
 		}
 
 		GetLogEntry(c.Request().Context()).Infof("Successfully returning %v messages", len(messageDtos))
@@ -94,6 +101,182 @@ func (mc *MessageHandler) GetMessages(c echo.Context) error {
 	}
 }
 
+func (mc *MessageHandler) GetThread(c echo.Context) error {
+	var userPrincipalDto, ok = c.Get(utils.USER_PRINCIPAL_DTO).(*auth.AuthResult)
+	if !ok {
+		GetLogEntry(c.Request().Context()).Errorf("Error during getting auth context")
+		return errors.New("Error during getting auth context")
+	}
+
+	chatId, err := GetPathParamAsInt64(c, "id")
+	if err != nil {
+		return err
+	}
+
+	messageId, err := GetPathParamAsInt64(c, "messageId")
+	if err != nil {
+		return err
+	}
+
+	var startingFromItemId int64
+	startingFromItemIdString := c.QueryParam("startingFromItemId")
+	if startingFromItemIdString != "" {
+		startingFromItemId, err = utils.ParseInt64(startingFromItemIdString) // exclusive
+		if err != nil {
+			return err
+		}
+	}
+	size := utils.FixSizeString(c.QueryParam("size"))
+
+	// also checks the participance
+	rootMessage, err := mc.db.GetMessage(chatId, userPrincipalDto.UserId, messageId)
+	if err != nil {
+		return err
+	}
+	if rootMessage == nil {
+		return c.NoContent(http.StatusNotFound)
+	}
+
+	messages, err := mc.db.GetThread(chatId, userPrincipalDto.UserId, messageId, size, startingFromItemId)
+	if err != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error get thread from db %v", err)
+		return err
+	}
+	messageDtos, err := convertToMessageDtos(c, &mc.db, mc.restClient, chatId, messages, userPrincipalDto.UserId)
+	if err != nil {
+		return err
+	}
+
+	GetLogEntry(c.Request().Context()).Infof("Successfully returning %v thread messages", len(messageDtos))
+	return c.JSON(http.StatusOK, messageDtos)
+}
+
+func (mc *MessageHandler) SearchMessages(c echo.Context) error {
+	var userPrincipalDto, ok = c.Get(utils.USER_PRINCIPAL_DTO).(*auth.AuthResult)
+	if !ok {
+		GetLogEntry(c.Request().Context()).Errorf("Error during getting auth context")
+		return errors.New("Error during getting auth context")
+	}
+
+	page := utils.FixPageString(c.QueryParam("page"))
+	size := utils.FixSizeString(c.QueryParam("size"))
+	offset := utils.GetOffset(page, size)
+
+	searchString := TrimAmdSanitize(mc.policy, c.QueryParam("searchString"))
+	if searchString == "" {
+		return c.JSON(http.StatusOK, make([]*dto.FoundMessageDto, 0))
+	}
+
+	foundMessages, err := mc.db.SearchMessages(userPrincipalDto.UserId, searchString, size, offset)
+	if err != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error search messages in db %v", err)
+		return err
+	}
+
+	foundDtos := convertToFoundMessageDtos(c, &mc.db, mc.restClient, foundMessages)
+
+	GetLogEntry(c.Request().Context()).Infof("Successfully returning %v found messages", len(foundDtos))
+	return c.JSON(http.StatusOK, foundDtos)
+}
+
+func convertToFoundMessageDtos(c echo.Context, co db.CommonOperations, restClient client.RestClient, foundMessages []*db.FoundMessage) []*dto.FoundMessageDto {
+	var ownersSet = map[int64]bool{}
+	for _, found := range foundMessages {
+		ownersSet[found.OwnerId] = true
+	}
+	var owners = getOwnersOrEmpty(co, ownersSet, restClient, c)
+
+	foundDtos := make([]*dto.FoundMessageDto, 0)
+	for _, found := range foundMessages {
+		foundDtos = append(foundDtos, &dto.FoundMessageDto{
+			Id:             found.Id,
+			ChatId:         found.ChatId,
+			OwnerId:        found.OwnerId,
+			Owner:          getOwnerOrStub(owners, found.OwnerId),
+			CreateDateTime: found.CreateDateTime,
+			Highlight:      found.Highlight,
+			Rank:           found.Rank,
+		})
+	}
+	return foundDtos
+}
+
+// additional data which is stored outside of message_chat_N and is needed for DisplayMessageDto
+type messageExtras struct {
+	owners    map[int64]*dto.User
+	replies   map[int64]*db.Message
+	reactions map[int64][]*db.ReactionCount
+	readBy    map[int64]int64
+	previews  map[string]*db.LinkPreview
+}
+
+func getMessageExtras(c echo.Context, co db.CommonOperations, restClient client.RestClient, chatId int64, messages []*db.Message, behalfUserId int64) (*messageExtras, error) {
+	var messageIds = []int64{}
+	var replyToIds = []int64{}
+	for _, message := range messages {
+		messageIds = append(messageIds, message.Id)
+		if message.ReplyToMessageId.Valid {
+			replyToIds = append(replyToIds, message.ReplyToMessageId.Int64)
+		}
+	}
+	replies, err := co.GetMessagesByIds(chatId, replyToIds)
+	if err != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error get reply messages from db %v", err)
+		return nil, err
+	}
+	reactions, err := co.GetReactions(chatId, messageIds)
+	if err != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error get reactions from db %v", err)
+		return nil, err
+	}
+	readBy, err := co.GetReadByCounts(chatId, messageIds)
+	if err != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error get read by counts from db %v", err)
+		return nil, err
+	}
+	var links = []string{}
+	for _, message := range messages {
+		links = append(links, services.ExtractLinks(message.Text, viper.GetInt("linkPreviews.maxPerMessage"))...)
+	}
+	previews, err := co.GetLinkPreviews(links)
+	if err != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error get link previews from db %v", err)
+		return nil, err
+	}
+
+	var ownersSet = map[int64]bool{}
+	ownersSet[behalfUserId] = true
+	for _, message := range messages {
+		ownersSet[message.OwnerId] = true
+		if message.ForwardedFromOwnerId.Valid {
+			ownersSet[message.ForwardedFromOwnerId.Int64] = true
+		}
+	}
+	for _, reply := range replies {
+		ownersSet[reply.OwnerId] = true
+	}
+	var owners = getOwnersOrEmpty(co, ownersSet, restClient, c)
+	return &messageExtras{
+		owners:    owners,
+		replies:   replies,
+		reactions: reactions,
+		readBy:    readBy,
+		previews:  previews,
+	}, nil
+}
+
+func convertToMessageDtos(c echo.Context, co db.CommonOperations, restClient client.RestClient, chatId int64, messages []*db.Message, behalfUserId int64) ([]*dto.DisplayMessageDto, error) {
+	extras, err := getMessageExtras(c, co, restClient, chatId, messages, behalfUserId)
+	if err != nil {
+		return nil, err
+	}
+	messageDtos := make([]*dto.DisplayMessageDto, 0)
+	for _, message := range messages {
+		messageDtos = append(messageDtos, convertToMessageDto(message, extras, behalfUserId))
+	}
+	return messageDtos, nil
+}
+
 func getMessage(c echo.Context, co db.CommonOperations, restClient client.RestClient, chatId int64, messageId int64, behalfUserId int64) (*dto.DisplayMessageDto, error) {
 	if message, err := co.GetMessage(chatId, behalfUserId, messageId); err != nil {
 		GetLogEntry(c.Request().Context()).Errorf("Error get messages from db %v", err)
@@ -102,10 +285,11 @@ func getMessage(c echo.Context, co db.CommonOperations, restClient client.RestCl
 		if message == nil {
 			return nil, nil
 		}
-		var ownersSet = map[int64]bool{}
-		ownersSet[behalfUserId] = true
-		var owners = getUsersRemotelyOrEmpty(ownersSet, restClient, c)
-		return convertToMessageDto(message, owners, behalfUserId), nil
+		extras, err := getMessageExtras(c, co, restClient, chatId, []*db.Message{message}, behalfUserId)
+		if err != nil {
+			return nil, err
+		}
+		return convertToMessageDto(message, extras, behalfUserId), nil
 	}
 }
 
@@ -137,20 +321,94 @@ func (mc *MessageHandler) GetMessage(c echo.Context) error {
 	return c.JSON(http.StatusOK, message)
 }
 
-func convertToMessageDto(dbMessage *db.Message, owners map[int64]*dto.User, behalfUserId int64) *dto.DisplayMessageDto {
-	user := owners[dbMessage.OwnerId]
+func getOwnerOrStub(owners map[int64]*dto.User, ownerId int64) *dto.User {
+	user := owners[ownerId]
 	if user == nil {
-		user = &dto.User{Login: fmt.Sprintf("user%v", dbMessage.OwnerId), Id: dbMessage.OwnerId}
+		user = &dto.User{Login: fmt.Sprintf("user%v", ownerId), Id: ownerId}
 	}
+	return user
+}
+
+const quotedTextMaxLength = 128
+
+// strips tags and shortens the text for quoting
+func shortenText(html string) string {
+	text := []rune(strings.TrimSpace(strip.StripTags(html)))
+	if len(text) > quotedTextMaxLength {
+		text = append(text[:quotedTextMaxLength], '…')
+	}
+	return string(text)
+}
+
+func convertToReplyToMessageDto(dbMessage *db.Message, owners map[int64]*dto.User) *dto.ReplyToMessageDto {
+	return &dto.ReplyToMessageDto{
+		Id:      dbMessage.Id,
+		OwnerId: dbMessage.OwnerId,
+		Owner:   getOwnerOrStub(owners, dbMessage.OwnerId),
+		Text:    shortenText(dbMessage.Text),
+	}
+}
+
+func convertToReactionDtos(reactions []*db.ReactionCount) []*dto.ReactionDto {
+	ret := make([]*dto.ReactionDto, 0)
+	for _, reaction := range reactions {
+		ret = append(ret, &dto.ReactionDto{
+			Reaction: reaction.Reaction,
+			Count:    reaction.Count,
+		})
+	}
+	return ret
+}
+
+// convertToLinkPreviewDtos skips the links which aren't fetched yet or have failed
+func convertToLinkPreviewDtos(text string, previews map[string]*db.LinkPreview) []*dto.LinkPreviewDto {
+	ret := make([]*dto.LinkPreviewDto, 0)
+	for _, link := range services.ExtractLinks(text, viper.GetInt("linkPreviews.maxPerMessage")) {
+		if preview, ok := previews[link]; ok && preview.Success {
+			ret = append(ret, &dto.LinkPreviewDto{
+				Url:         preview.Url,
+				Title:       preview.Title,
+				Description: preview.Description,
+				ImageUrl:    preview.ImageUrl,
+				SiteName:    preview.SiteName,
+			})
+		}
+	}
+	return ret
+}
+
+func convertToMessageDto(dbMessage *db.Message, extras *messageExtras, behalfUserId int64) *dto.DisplayMessageDto {
 	ret := &dto.DisplayMessageDto{
-		Id:             dbMessage.Id,
-		Text:           dbMessage.Text,
-		ChatId:         dbMessage.ChatId,
-		OwnerId:        dbMessage.OwnerId,
-		CreateDateTime: dbMessage.CreateDateTime,
-		EditDateTime:   dbMessage.EditDateTime,
-		Owner:          user,
-		FileItemUuid:   dbMessage.FileItemUuid,
+		Id:               dbMessage.Id,
+		Text:             dbMessage.Text,
+		ChatId:           dbMessage.ChatId,
+		OwnerId:          dbMessage.OwnerId,
+		CreateDateTime:   dbMessage.CreateDateTime,
+		EditDateTime:     dbMessage.EditDateTime,
+		Owner:            getOwnerOrStub(extras.owners, dbMessage.OwnerId),
+		FileItemUuid:     dbMessage.FileItemUuid,
+		ReplyToMessageId: dbMessage.ReplyToMessageId,
+		Reactions:        convertToReactionDtos(extras.reactions[dbMessage.Id]),
+		Pinned:           dbMessage.Pinned,
+		ReadBy:           extras.readBy[dbMessage.Id],
+		Bot:              db.IsBotOwnerId(dbMessage.OwnerId),
+		LinkPreviews:     convertToLinkPreviewDtos(dbMessage.Text, extras.previews),
+	}
+
+	if dbMessage.ReplyToMessageId.Valid {
+		// the parent can be already deleted
+		if reply, ok := extras.replies[dbMessage.ReplyToMessageId.Int64]; ok {
+			ret.ReplyTo = convertToReplyToMessageDto(reply, extras.owners)
+		}
+	}
+
+	if dbMessage.ForwardedFromMessageId.Valid {
+		ret.ForwardedFrom = &dto.ForwardedFromDto{
+			ChatId:    dbMessage.ForwardedFromChatId.Int64,
+			MessageId: dbMessage.ForwardedFromMessageId.Int64,
+			OwnerId:   dbMessage.ForwardedFromOwnerId.Int64,
+			Owner:     getOwnerOrStub(extras.owners, dbMessage.ForwardedFromOwnerId.Int64),
+		}
 	}
 
 	ret.SetPersonalizedFields(behalfUserId)
@@ -195,10 +453,18 @@ func (mc *MessageHandler) PostMessage(c echo.Context) error {
 		return err
 	}
 
+	if bindTo.FileItemUuid == nil {
+		if name, args, ok := parseSlashCommand(bindTo.Text); ok {
+			if command := findSlashCommand(name); command != nil {
+				return mc.executeSlashCommand(c, command, userPrincipalDto, chatId, args)
+			}
+		}
+	}
+
 	errOuter := db.Transact(mc.db, func(tx *db.Tx) error {
-		if participant, err := tx.IsParticipant(userPrincipalDto.UserId, chatId); err != nil {
+		if permitted, err := canWriteMessage(tx, userPrincipalDto.UserId, chatId); err != nil {
 			return err
-		} else if !participant {
+		} else if !permitted {
 			return c.JSON(http.StatusBadRequest, &utils.H{"message": "You are not allowed to write to this chat"})
 		}
 		creatableMessage := convertToCreatableMessage(bindTo, userPrincipalDto, chatId, mc.policy)
@@ -206,28 +472,17 @@ func (mc *MessageHandler) PostMessage(c echo.Context) error {
 			GetLogEntry(c.Request().Context()).Infof("Empty message doesn't save")
 			return noContent(c)
 		}
-		id, _, _, err := tx.CreateMessage(creatableMessage)
-		if err != nil {
-			return err
-		}
-		if tx.AddMessageRead(id, userPrincipalDto.UserId, chatId) != nil {
-			return err
-		}
-		if tx.UpdateChatLastDatetimeChat(chatId) != nil {
-			return err
-		}
-
-		participantIds, err := tx.GetAllParticipantIds(chatId)
-		if err != nil {
-			return err
+		if creatableMessage.ReplyToMessageId.Valid {
+			if replyTo, err := tx.GetMessage(chatId, userPrincipalDto.UserId, creatableMessage.ReplyToMessageId.Int64); err != nil {
+				return err
+			} else if replyTo == nil {
+				return c.JSON(http.StatusBadRequest, &utils.H{"message": "Message to reply is not found"})
+			}
 		}
-		message, err := getMessage(c, tx, mc.restClient, chatId, id, userPrincipalDto.UserId)
+		message, err := mc.createMessage(c, tx, creatableMessage)
 		if err != nil {
 			return err
 		}
-		mc.notificator.NotifyAboutNewMessage(c, participantIds, chatId, message)
-		mc.notificator.ChatNotifyMessageCount(participantIds, c, chatId, tx)
-		mc.notificator.ChatNotifyAllUnreadMessageCount(participantIds, c, tx)
 		return c.JSON(http.StatusCreated, message)
 	})
 	if errOuter != nil {
@@ -236,12 +491,45 @@ func (mc *MessageHandler) PostMessage(c echo.Context) error {
 	return errOuter
 }
 
+// saves the already validated message and notifies the participants, it doesn't write the response
+func (mc *MessageHandler) createMessage(c echo.Context, tx *db.Tx, creatableMessage *db.Message) (*dto.DisplayMessageDto, error) {
+	chatId := creatableMessage.ChatId
+	id, _, _, err := tx.CreateMessage(creatableMessage)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tx.AddMessageRead(id, creatableMessage.OwnerId, chatId); err != nil {
+		return nil, err
+	}
+	if err := tx.UpdateChatLastDatetimeChat(chatId); err != nil {
+		return nil, err
+	}
+
+	participantIds, err := tx.GetAllParticipantIds(chatId)
+	if err != nil {
+		return nil, err
+	}
+	message, err := getMessage(c, tx, mc.restClient, chatId, id, creatableMessage.OwnerId)
+	if err != nil {
+		return nil, err
+	}
+	if err := mc.processMentions(c, tx, message, participantIds); err != nil {
+		return nil, err
+	}
+	mc.notificator.NotifyAboutNewMessage(c, participantIds, chatId, message)
+	mc.notificator.ChatNotifyMessageCount(participantIds, c, chatId, tx)
+	mc.notificator.ChatNotifyAllUnreadMessageCount(participantIds, c, tx)
+	mc.fetchLinkPreviews(tx, chatId, id, creatableMessage.Text)
+	return message, nil
+}
+
 func convertToCreatableMessage(dto *CreateMessageDto, authPrincipal *auth.AuthResult, chatId int64, policy *bluemonday.Policy) *db.Message {
 	return &db.Message{
-		Text:         TrimAmdSanitize(policy, dto.Text),
-		ChatId:       chatId,
-		OwnerId:      authPrincipal.UserId,
-		FileItemUuid: dto.FileItemUuid,
+		Text:             TrimAmdSanitize(policy, dto.Text),
+		ChatId:           chatId,
+		OwnerId:          authPrincipal.UserId,
+		FileItemUuid:     dto.FileItemUuid,
+		ReplyToMessageId: dto.ReplyToMessageId,
 	}
 }
 
@@ -286,7 +574,11 @@ func (mc *MessageHandler) EditMessage(c echo.Context) error {
 		if err != nil {
 			return err
 		}
+		if err := mc.processMentions(c, tx, message, ids); err != nil {
+			return err
+		}
 		mc.notificator.NotifyAboutEditMessage(c, ids, chatId, message)
+		mc.fetchLinkPreviews(tx, chatId, bindTo.Id, editableMessage.Text)
 
 		return c.JSON(http.StatusCreated, &utils.H{"id": bindTo.Id})
 	})
@@ -296,6 +588,101 @@ func (mc *MessageHandler) EditMessage(c echo.Context) error {
 	return errOuter
 }
 
+type ForwardMessageDto struct {
+	ChatIds []int64 `json:"chatIds"`
+}
+
+func (a *ForwardMessageDto) Validate() error {
+	return validation.ValidateStruct(a, validation.Field(&a.ChatIds, validation.Required, validation.Length(1, 32)))
+}
+
+// ForwardMessage copies the message into the target chats on behalf of the current user, keeping the attribution to the original author
+func (mc *MessageHandler) ForwardMessage(c echo.Context) error {
+	var bindTo = new(ForwardMessageDto)
+	if err := c.Bind(bindTo); err != nil {
+		GetLogEntry(c.Request().Context()).Warnf("Error during binding to dto %v", err)
+		return err
+	}
+
+	if valid, err := ValidateAndRespondError(c, bindTo); err != nil || !valid {
+		return err
+	}
+
+	var userPrincipalDto, ok = c.Get(utils.USER_PRINCIPAL_DTO).(*auth.AuthResult)
+	if !ok {
+		GetLogEntry(c.Request().Context()).Errorf("Error during getting auth context")
+		return errors.New("Error during getting auth context")
+	}
+
+	chatId, err := GetPathParamAsInt64(c, "id")
+	if err != nil {
+		return err
+	}
+
+	messageId, err := GetPathParamAsInt64(c, "messageId")
+	if err != nil {
+		return err
+	}
+
+	errOuter := db.Transact(mc.db, func(tx *db.Tx) error {
+		if participant, err := tx.IsParticipant(userPrincipalDto.UserId, chatId); err != nil {
+			return err
+		} else if !participant {
+			return c.JSON(http.StatusUnauthorized, &utils.H{"message": "You have no access to this chat"})
+		}
+		source, err := tx.GetMessage(chatId, userPrincipalDto.UserId, messageId)
+		if err != nil {
+			return err
+		}
+		if source == nil {
+			return c.NoContent(http.StatusNotFound)
+		}
+
+		var targetChatIds = []int64{}
+		var targetChatIdsSet = map[int64]bool{}
+		for _, targetChatId := range bindTo.ChatIds {
+			if targetChatIdsSet[targetChatId] {
+				continue
+			}
+			if permitted, err := canWriteMessage(tx, userPrincipalDto.UserId, targetChatId); err != nil {
+				return err
+			} else if !permitted {
+				return c.JSON(http.StatusBadRequest, &utils.H{"message": fmt.Sprintf("You are not allowed to write to chat %v", targetChatId)})
+			}
+			targetChatIdsSet[targetChatId] = true
+			targetChatIds = append(targetChatIds, targetChatId)
+		}
+
+		// forwarding of the already forwarded message refers to the very original one
+		forwardedFromChatId, forwardedFromMessageId, forwardedFromOwnerId := null.IntFrom(chatId), null.IntFrom(messageId), null.IntFrom(source.OwnerId)
+		if source.ForwardedFromMessageId.Valid {
+			forwardedFromChatId, forwardedFromMessageId, forwardedFromOwnerId = source.ForwardedFromChatId, source.ForwardedFromMessageId, source.ForwardedFromOwnerId
+		}
+
+		var forwarded = make([]*dto.DisplayMessageDto, 0)
+		for _, targetChatId := range targetChatIds {
+			message, err := mc.createMessage(c, tx, &db.Message{
+				Text:                   source.Text,
+				ChatId:                 targetChatId,
+				OwnerId:                userPrincipalDto.UserId,
+				FileItemUuid:           source.FileItemUuid,
+				ForwardedFromChatId:    forwardedFromChatId,
+				ForwardedFromMessageId: forwardedFromMessageId,
+				ForwardedFromOwnerId:   forwardedFromOwnerId,
+			})
+			if err != nil {
+				return err
+			}
+			forwarded = append(forwarded, message)
+		}
+		return c.JSON(http.StatusCreated, forwarded)
+	})
+	if errOuter != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error during act transaction %v", errOuter)
+	}
+	return errOuter
+}
+
 func convertToEditableMessage(dto *EditMessageDto, authPrincipal *auth.AuthResult, chatId int64, policy *bluemonday.Policy) *db.Message {
 	return &db.Message{
 		Id:           dto.Id,
@@ -324,20 +711,279 @@ func (mc *MessageHandler) DeleteMessage(c echo.Context) error {
 		return err
 	}
 
-	if err := mc.db.DeleteMessage(messageId, userPrincipalDto.UserId, chatId); err != nil {
-		return err
-	} else {
+	errOuter := db.Transact(mc.db, func(tx *db.Tx) error {
+		if err := tx.DeleteMessage(messageId, userPrincipalDto.UserId, chatId); err != nil {
+			return err
+		}
+		if err := tx.DeleteMessageReactions(chatId, messageId); err != nil {
+			return err
+		}
+		if err := tx.DeleteMessageMentions(chatId, messageId); err != nil {
+			return err
+		}
 		cd := &dto.DisplayMessageDto{
 			Id:     messageId,
 			ChatId: chatId,
 		}
-		if ids, err := mc.db.GetAllParticipantIds(chatId); err != nil {
+		if ids, err := tx.GetAllParticipantIds(chatId); err != nil {
 			return err
 		} else {
 			mc.notificator.NotifyAboutDeleteMessage(c, ids, chatId, cd)
 		}
 		return c.JSON(http.StatusAccepted, &utils.H{"id": messageId})
+	})
+	if errOuter != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error during act transaction %v", errOuter)
 	}
+	return errOuter
+}
+
+type MessageReadersWrapper struct {
+	Data  []*dto.User `json:"data"`
+	Count int64       `json:"totalCount"` // total readers number of this message
+}
+
+func (mc *MessageHandler) GetMessageReaders(c echo.Context) error {
+	var userPrincipalDto, ok = c.Get(utils.USER_PRINCIPAL_DTO).(*auth.AuthResult)
+	if !ok {
+		GetLogEntry(c.Request().Context()).Errorf("Error during getting auth context")
+		return errors.New("Error during getting auth context")
+	}
+
+	chatId, err := GetPathParamAsInt64(c, "id")
+	if err != nil {
+		return err
+	}
+
+	messageId, err := GetPathParamAsInt64(c, "messageId")
+	if err != nil {
+		return err
+	}
+
+	page := utils.FixPageString(c.QueryParam("page"))
+	size := utils.FixSizeString(c.QueryParam("size"))
+	offset := utils.GetOffset(page, size)
+
+	message, err := mc.db.GetMessage(chatId, userPrincipalDto.UserId, messageId)
+	if err != nil {
+		return err
+	}
+	if message == nil {
+		return c.NoContent(http.StatusNotFound)
+	}
+
+	readerIds, err := mc.db.GetMessageReaders(chatId, message, size, offset)
+	if err != nil {
+		return err
+	}
+	readByCounts, err := mc.db.GetReadByCounts(chatId, []int64{messageId})
+	if err != nil {
+		return err
+	}
+
+	var readersSet = map[int64]bool{}
+	for _, readerId := range readerIds {
+		readersSet[readerId] = true
+	}
+	// the bots can read the messages as well
+	var readers = getOwnersOrEmpty(&mc.db, readersSet, mc.restClient, c)
+	var readerDtos = make([]*dto.User, 0)
+	for _, readerId := range readerIds {
+		readerDtos = append(readerDtos, getOwnerOrStub(readers, readerId))
+	}
+
+	return c.JSON(http.StatusOK, &MessageReadersWrapper{Data: readerDtos, Count: readByCounts[messageId]})
+}
+
+func (mc *MessageHandler) PinMessage(c echo.Context) error {
+	var userPrincipalDto, ok = c.Get(utils.USER_PRINCIPAL_DTO).(*auth.AuthResult)
+	if !ok {
+		GetLogEntry(c.Request().Context()).Errorf("Error during getting auth context")
+		return errors.New("Error during getting auth context")
+	}
+
+	chatId, err := GetPathParamAsInt64(c, "id")
+	if err != nil {
+		return err
+	}
+
+	messageId, err := GetPathParamAsInt64(c, "messageId")
+	if err != nil {
+		return err
+	}
+
+	pin, err := GetQueryParamAsBoolean(c, "pin")
+	if err != nil {
+		return err
+	}
+
+	errOuter := db.Transact(mc.db, func(tx *db.Tx) error {
+		if permitted, err := hasPermission(tx, userPrincipalDto.UserId, chatId, dto.PermissionPinMessage); err != nil {
+			return err
+		} else if !permitted {
+			return c.JSON(http.StatusUnauthorized, &utils.H{"message": "You have no access to this chat"})
+		}
+
+		message, err := getMessage(c, tx, mc.restClient, chatId, messageId, userPrincipalDto.UserId)
+		if err != nil {
+			return err
+		}
+		if message == nil {
+			return c.NoContent(http.StatusNotFound)
+		}
+
+		if err := tx.PinMessage(chatId, messageId, pin); err != nil {
+			return err
+		}
+		message.Pinned = pin
+
+		ids, err := tx.GetAllParticipantIds(chatId)
+		if err != nil {
+			return err
+		}
+		mc.notificator.NotifyAboutPinnedMessageChanged(c, ids, chatId, message)
+
+		return c.JSON(http.StatusOK, message)
+	})
+	if errOuter != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error during act transaction %v", errOuter)
+	}
+	return errOuter
+}
+
+func (mc *MessageHandler) GetPinnedMessages(c echo.Context) error {
+	var userPrincipalDto, ok = c.Get(utils.USER_PRINCIPAL_DTO).(*auth.AuthResult)
+	if !ok {
+		GetLogEntry(c.Request().Context()).Errorf("Error during getting auth context")
+		return errors.New("Error during getting auth context")
+	}
+
+	chatId, err := GetPathParamAsInt64(c, "id")
+	if err != nil {
+		return err
+	}
+
+	if messages, err := mc.db.GetPinnedMessages(chatId, userPrincipalDto.UserId); err != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error get pinned messages from db %v", err)
+		return err
+	} else {
+		messageDtos, err := convertToMessageDtos(c, &mc.db, mc.restClient, chatId, messages, userPrincipalDto.UserId)
+		if err != nil {
+			return err
+		}
+		return c.JSON(http.StatusOK, messageDtos)
+	}
+}
+
+type PutReactionDto struct {
+	Reaction string `json:"reaction"`
+}
+
+func (a *PutReactionDto) Validate() error {
+	return validation.ValidateStruct(a, validation.Field(&a.Reaction, validation.Required, validation.Length(1, 32)))
+}
+
+func (mc *MessageHandler) PutReaction(c echo.Context) error {
+	var bindTo = new(PutReactionDto)
+	if err := c.Bind(bindTo); err != nil {
+		GetLogEntry(c.Request().Context()).Warnf("Error during binding to dto %v", err)
+		return err
+	}
+
+	if valid, err := ValidateAndRespondError(c, bindTo); err != nil || !valid {
+		return err
+	}
+
+	var userPrincipalDto, ok = c.Get(utils.USER_PRINCIPAL_DTO).(*auth.AuthResult)
+	if !ok {
+		GetLogEntry(c.Request().Context()).Errorf("Error during getting auth context")
+		return errors.New("Error during getting auth context")
+	}
+
+	chatId, err := GetPathParamAsInt64(c, "id")
+	if err != nil {
+		return err
+	}
+
+	messageId, err := GetPathParamAsInt64(c, "messageId")
+	if err != nil {
+		return err
+	}
+
+	reaction := TrimAmdSanitize(mc.policy, bindTo.Reaction)
+	if reaction == "" {
+		return c.JSON(http.StatusBadRequest, &utils.H{"message": "Reaction is empty"})
+	}
+
+	errOuter := db.Transact(mc.db, func(tx *db.Tx) error {
+		return mc.changeReaction(c, tx, chatId, messageId, userPrincipalDto.UserId, func() error {
+			return tx.PutReaction(chatId, messageId, userPrincipalDto.UserId, reaction)
+		})
+	})
+	if errOuter != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error during act transaction %v", errOuter)
+	}
+	return errOuter
+}
+
+func (mc *MessageHandler) DeleteReaction(c echo.Context) error {
+	var userPrincipalDto, ok = c.Get(utils.USER_PRINCIPAL_DTO).(*auth.AuthResult)
+	if !ok {
+		GetLogEntry(c.Request().Context()).Errorf("Error during getting auth context")
+		return errors.New("Error during getting auth context")
+	}
+
+	chatId, err := GetPathParamAsInt64(c, "id")
+	if err != nil {
+		return err
+	}
+
+	messageId, err := GetPathParamAsInt64(c, "messageId")
+	if err != nil {
+		return err
+	}
+
+	errOuter := db.Transact(mc.db, func(tx *db.Tx) error {
+		return mc.changeReaction(c, tx, chatId, messageId, userPrincipalDto.UserId, func() error {
+			return tx.DeleteReaction(chatId, messageId, userPrincipalDto.UserId)
+		})
+	})
+	if errOuter != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error during act transaction %v", errOuter)
+	}
+	return errOuter
+}
+
+// checks the message is visible for the user, applies the change and sends the actual reactions to the participants
+func (mc *MessageHandler) changeReaction(c echo.Context, tx *db.Tx, chatId, messageId, userId int64, change func() error) error {
+	message, err := tx.GetMessage(chatId, userId, messageId)
+	if err != nil {
+		return err
+	}
+	if message == nil {
+		return c.NoContent(http.StatusNotFound)
+	}
+
+	if err := change(); err != nil {
+		return err
+	}
+
+	reactions, err := tx.GetReactions(chatId, []int64{messageId})
+	if err != nil {
+		return err
+	}
+	reactionChanged := &dto.ReactionChangedDto{
+		MessageId: messageId,
+		Reactions: convertToReactionDtos(reactions[messageId]),
+	}
+
+	ids, err := tx.GetAllParticipantIds(chatId)
+	if err != nil {
+		return err
+	}
+	mc.notificator.NotifyAboutReactionChanged(c, ids, chatId, reactionChanged)
+
+	return c.JSON(http.StatusOK, reactionChanged)
 }
 
 func getNewMessagesNotification(dbs db.DB, userId int64) (*dto.AllUnreadMessages, error) {
@@ -365,9 +1011,23 @@ func (mc MessageHandler) ReadMessage(c echo.Context) error {
 		return err
 	}
 
-	if err := mc.db.AddMessageRead(messageId, userPrincipalDto.UserId, chatId); err != nil {
+	advanced, err := mc.db.AddMessageRead(messageId, userPrincipalDto.UserId, chatId)
+	if err != nil {
 		return err
 	}
+	if advanced {
+		participantIds, err := mc.db.GetAllParticipantIds(chatId)
+		if err != nil {
+			return err
+		}
+		if utils.Contains(participantIds, userPrincipalDto.UserId) {
+			mc.notificator.NotifyAboutMessageRead(c, utils.Remove(participantIds, userPrincipalDto.UserId), chatId, &dto.MessageReadDto{
+				ChatId:        chatId,
+				LastMessageId: messageId,
+				UserId:        userPrincipalDto.UserId,
+			})
+		}
+	}
 
 	notification, err := getNewMessagesNotification(mc.db, userPrincipalDto.UserId)
 	if err != nil {
@@ -435,10 +1095,10 @@ func (mc *MessageHandler) BroadcastMessage(c echo.Context) error {
 		return err
 	}
 
-	if participant, err := mc.db.IsAdmin(userPrincipalDto.UserId, chatId); err != nil {
+	if permitted, err := hasPermission(&mc.db, userPrincipalDto.UserId, chatId, dto.PermissionBroadcast); err != nil {
 		GetLogEntry(c.Request().Context()).Errorf("Error during checking participant")
 		return err
-	} else if !participant {
+	} else if !permitted {
 		GetLogEntry(c.Request().Context()).Infof("User %v is not participant of chat %v, skipping", userPrincipalDto.UserId, chatId)
 		return c.NoContent(http.StatusAccepted)
 	}
@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"errors"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"nkonev.name/chat/auth"
+	"nkonev.name/chat/client"
+	"nkonev.name/chat/db"
+	"nkonev.name/chat/dto"
+	. "nkonev.name/chat/logger"
+	"nkonev.name/chat/utils"
+)
+
+func convertToMessageVersionDtos(c echo.Context, restClient client.RestClient, versions []*db.MessageVersion) []*dto.MessageVersionDto {
+	var ownersSet = map[int64]bool{}
+	for _, version := range versions {
+		ownersSet[version.OwnerId] = true
+	}
+	var owners = getUsersRemotelyOrEmpty(ownersSet, restClient, c)
+
+	var ret = make([]*dto.MessageVersionDto, 0)
+	for _, version := range versions {
+		ret = append(ret, &dto.MessageVersionDto{
+			MessageId:       version.MessageId,
+			OwnerId:         version.OwnerId,
+			Owner:           getOwnerOrStub(owners, version.OwnerId),
+			Text:            version.Text,
+			FileItemUuid:    version.FileItemUuid,
+			VersionDateTime: version.VersionDateTime,
+			Action:          version.Action,
+			ActionDateTime:  version.ActionDateTime,
+		})
+	}
+	return ret
+}
+
+// GetMessageHistory is available for the author and the chat moderators, the history of the deleted message is available only for the moderators
+func (mc *MessageHandler) GetMessageHistory(c echo.Context) error {
+	var userPrincipalDto, ok = c.Get(utils.USER_PRINCIPAL_DTO).(*auth.AuthResult)
+	if !ok {
+		GetLogEntry(c.Request().Context()).Errorf("Error during getting auth context")
+		return errors.New("Error during getting auth context")
+	}
+
+	chatId, err := GetPathParamAsInt64(c, "id")
+	if err != nil {
+		return err
+	}
+
+	messageId, err := GetPathParamAsInt64(c, "messageId")
+	if err != nil {
+		return err
+	}
+
+	message, err := mc.db.GetMessage(chatId, userPrincipalDto.UserId, messageId)
+	if err != nil {
+		return err
+	}
+	admin, err := hasPermission(&mc.db, userPrincipalDto.UserId, chatId, dto.PermissionModerateMessages)
+	if err != nil {
+		return err
+	}
+	if message == nil && !admin {
+		return c.NoContent(http.StatusNotFound)
+	}
+	if message != nil && !admin && message.OwnerId != userPrincipalDto.UserId {
+		return c.JSON(http.StatusUnauthorized, &utils.H{"message": "You have no access to this message"})
+	}
+
+	versions, err := mc.db.GetMessageHistory(chatId, messageId)
+	if err != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error get message history from db %v", err)
+		return err
+	}
+	if message == nil && len(versions) == 0 {
+		return c.NoContent(http.StatusNotFound)
+	}
+
+	return c.JSON(http.StatusOK, &dto.MessageHistoryDto{
+		MessageId: messageId,
+		ChatId:    chatId,
+		Deleted:   message == nil,
+		Versions:  convertToMessageVersionDtos(c, mc.restClient, versions),
+	})
+}
+
+// GetDeletedMessages returns tombstones of the deleted messages, the newest first
+func (mc *MessageHandler) GetDeletedMessages(c echo.Context) error {
+	var userPrincipalDto, ok = c.Get(utils.USER_PRINCIPAL_DTO).(*auth.AuthResult)
+	if !ok {
+		GetLogEntry(c.Request().Context()).Errorf("Error during getting auth context")
+		return errors.New("Error during getting auth context")
+	}
+
+	chatId, err := GetPathParamAsInt64(c, "id")
+	if err != nil {
+		return err
+	}
+
+	page := utils.FixPageString(c.QueryParam("page"))
+	size := utils.FixSizeString(c.QueryParam("size"))
+	offset := utils.GetOffset(page, size)
+
+	if permitted, err := hasPermission(&mc.db, userPrincipalDto.UserId, chatId, dto.PermissionModerateMessages); err != nil {
+		return err
+	} else if !permitted {
+		return c.JSON(http.StatusUnauthorized, &utils.H{"message": "You have no access to this chat"})
+	}
+
+	versions, err := mc.db.GetDeletedMessages(chatId, size, offset)
+	if err != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error get deleted messages from db %v", err)
+		return err
+	}
+	return c.JSON(http.StatusOK, convertToMessageVersionDtos(c, mc.restClient, versions))
+}
@@ -0,0 +1,237 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"nkonev.name/chat/auth"
+	"nkonev.name/chat/db"
+	. "nkonev.name/chat/logger"
+	"nkonev.name/chat/utils"
+	"time"
+)
+
+type CreateScheduledMessageDto struct {
+	CreateMessageDto
+	SendAt time.Time `json:"sendAt"`
+}
+
+type ScheduledMessageDto struct {
+	Id               int64      `json:"id"`
+	ChatId           int64      `json:"chatId"`
+	Text             string     `json:"text"`
+	FileItemUuid     *uuid.UUID `json:"fileItemUuid"`
+	ReplyToMessageId null.Int   `json:"replyToMessageId"`
+	SendAt           time.Time  `json:"sendAt"`
+	CreateDateTime   time.Time  `json:"createDateTime"`
+	FailDateTime     null.Time  `json:"failDateTime"`
+}
+
+func (a *CreateScheduledMessageDto) Validate() error {
+	return validation.ValidateStruct(a,
+		validation.Field(&a.Text, validation.Required, validation.Length(1, 1024*1024)),
+		validation.Field(&a.SendAt, validation.Required, validation.Min(time.Now()).Error("must be in the future")),
+	)
+}
+
+func convertToScheduledMessageDto(message *db.ScheduledMessage) *ScheduledMessageDto {
+	return &ScheduledMessageDto{
+		Id:               message.Id,
+		ChatId:           message.ChatId,
+		Text:             message.Text,
+		FileItemUuid:     message.FileItemUuid,
+		ReplyToMessageId: message.ReplyToMessageId,
+		SendAt:           message.SendAt,
+		CreateDateTime:   message.CreateDateTime,
+		FailDateTime:     message.FailDateTime,
+	}
+}
+
+func (mc *MessageHandler) PostScheduledMessage(c echo.Context) error {
+	var bindTo = new(CreateScheduledMessageDto)
+	if err := c.Bind(bindTo); err != nil {
+		GetLogEntry(c.Request().Context()).Warnf("Error during binding to dto %v", err)
+		return err
+	}
+
+	if valid, err := ValidateAndRespondError(c, bindTo); err != nil || !valid {
+		return err
+	}
+
+	var userPrincipalDto, ok = c.Get(utils.USER_PRINCIPAL_DTO).(*auth.AuthResult)
+	if !ok {
+		GetLogEntry(c.Request().Context()).Errorf("Error during getting auth context")
+		return errors.New("Error during getting auth context")
+	}
+
+	chatId, err := GetPathParamAsInt64(c, "id")
+	if err != nil {
+		return err
+	}
+
+	if permitted, err := canWriteMessage(&mc.db, userPrincipalDto.UserId, chatId); err != nil {
+		return err
+	} else if !permitted {
+		return c.JSON(http.StatusBadRequest, &utils.H{"message": "You are not allowed to write to this chat"})
+	}
+
+	creatableMessage := convertToCreatableMessage(&bindTo.CreateMessageDto, userPrincipalDto, chatId, mc.policy)
+	if creatableMessage.Text == "" {
+		GetLogEntry(c.Request().Context()).Infof("Empty message doesn't save")
+		return noContent(c)
+	}
+	if creatableMessage.ReplyToMessageId.Valid {
+		if replyTo, err := mc.db.GetMessage(chatId, userPrincipalDto.UserId, creatableMessage.ReplyToMessageId.Int64); err != nil {
+			return err
+		} else if replyTo == nil {
+			return c.JSON(http.StatusBadRequest, &utils.H{"message": "Message to reply is not found"})
+		}
+	}
+
+	scheduledMessage := &db.ScheduledMessage{
+		ChatId:           chatId,
+		OwnerId:          userPrincipalDto.UserId,
+		Text:             creatableMessage.Text,
+		FileItemUuid:     creatableMessage.FileItemUuid,
+		ReplyToMessageId: creatableMessage.ReplyToMessageId,
+		SendAt:           bindTo.SendAt.UTC(),
+	}
+	id, createDateTime, err := mc.db.CreateScheduledMessage(scheduledMessage)
+	if err != nil {
+		return err
+	}
+	scheduledMessage.Id = id
+	scheduledMessage.CreateDateTime = createDateTime
+
+	return c.JSON(http.StatusCreated, convertToScheduledMessageDto(scheduledMessage))
+}
+
+// returns only the current user's scheduled messages
+func (mc *MessageHandler) GetScheduledMessages(c echo.Context) error {
+	var userPrincipalDto, ok = c.Get(utils.USER_PRINCIPAL_DTO).(*auth.AuthResult)
+	if !ok {
+		GetLogEntry(c.Request().Context()).Errorf("Error during getting auth context")
+		return errors.New("Error during getting auth context")
+	}
+
+	chatId, err := GetPathParamAsInt64(c, "id")
+	if err != nil {
+		return err
+	}
+
+	messages, err := mc.db.GetScheduledMessages(chatId, userPrincipalDto.UserId)
+	if err != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error get scheduled messages from db %v", err)
+		return err
+	}
+
+	messageDtos := make([]*ScheduledMessageDto, 0)
+	for _, message := range messages {
+		messageDtos = append(messageDtos, convertToScheduledMessageDto(message))
+	}
+	return c.JSON(http.StatusOK, messageDtos)
+}
+
+func (mc *MessageHandler) CancelScheduledMessage(c echo.Context) error {
+	var userPrincipalDto, ok = c.Get(utils.USER_PRINCIPAL_DTO).(*auth.AuthResult)
+	if !ok {
+		GetLogEntry(c.Request().Context()).Errorf("Error during getting auth context")
+		return errors.New("Error during getting auth context")
+	}
+
+	chatId, err := GetPathParamAsInt64(c, "id")
+	if err != nil {
+		return err
+	}
+
+	scheduledMessageId, err := GetPathParamAsInt64(c, "scheduledMessageId")
+	if err != nil {
+		return err
+	}
+
+	deleted, err := mc.db.DeleteScheduledMessage(scheduledMessageId, chatId, userPrincipalDto.UserId)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return c.NoContent(http.StatusNotFound)
+	}
+	return c.JSON(http.StatusAccepted, &utils.H{"id": scheduledMessageId})
+}
+
+// SendDueScheduledMessages is invoked by the scheduler. Every message is sent in its own transaction through the same path as PostMessage.
+func (mc *MessageHandler) SendDueScheduledMessages(ctx context.Context, maxMessages int) {
+	// notifications and users fetching expect the echo context, there is no http request here
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, "/internal/scheduled-message", nil)
+	if err != nil {
+		Logger.Errorf("Error during creating context for scheduled messages %v", err)
+		return
+	}
+	c := echo.New().NewContext(request, nil)
+
+	for i := 0; i < maxMessages; i++ {
+		var dueId int64
+		err := db.Transact(mc.db, func(tx *db.Tx) error {
+			scheduledMessage, err := tx.GetNextDueScheduledMessage()
+			if err != nil || scheduledMessage == nil {
+				return err
+			}
+			dueId = scheduledMessage.Id
+			return mc.sendScheduledMessage(c, tx, scheduledMessage)
+		})
+		if err != nil && dueId != 0 {
+			// otherwise it would be taken first again and block the messages after it
+			Logger.Errorf("Error during sending scheduled message %v, marking it as failed %v", dueId, err)
+			if err := mc.db.MarkScheduledMessageFailed(dueId); err != nil {
+				return
+			}
+			continue
+		}
+		if err != nil {
+			Logger.Errorf("Error during getting due scheduled message %v", err)
+			return
+		}
+		if dueId == 0 {
+			return
+		}
+	}
+}
+
+func (mc *MessageHandler) sendScheduledMessage(c echo.Context, tx *db.Tx, scheduledMessage *db.ScheduledMessage) error {
+	if err := tx.DeleteScheduledMessageById(scheduledMessage.Id); err != nil {
+		return err
+	}
+
+	// the situation could change since the message was scheduled
+	if permitted, err := canWriteMessage(tx, scheduledMessage.OwnerId, scheduledMessage.ChatId); err != nil {
+		return err
+	} else if !permitted {
+		Logger.Infof("Skipping scheduled message %v because user %v isn't allowed to write to chat %v anymore", scheduledMessage.Id, scheduledMessage.OwnerId, scheduledMessage.ChatId)
+		return nil
+	}
+	creatableMessage := &db.Message{
+		Text:             scheduledMessage.Text,
+		ChatId:           scheduledMessage.ChatId,
+		OwnerId:          scheduledMessage.OwnerId,
+		FileItemUuid:     scheduledMessage.FileItemUuid,
+		ReplyToMessageId: scheduledMessage.ReplyToMessageId,
+	}
+	if creatableMessage.ReplyToMessageId.Valid {
+		if replyTo, err := tx.GetMessage(creatableMessage.ChatId, creatableMessage.OwnerId, creatableMessage.ReplyToMessageId.Int64); err != nil {
+			return err
+		} else if replyTo == nil {
+			creatableMessage.ReplyToMessageId = null.Int{}
+		}
+	}
+
+	message, err := mc.createMessage(c, tx, creatableMessage)
+	if err != nil {
+		return err
+	}
+	Logger.Infof("Scheduled message %v has been sent as message %v to chat %v", scheduledMessage.Id, message.Id, message.ChatId)
+	return nil
+}
@@ -0,0 +1,209 @@
+package handlers
+
+import (
+	"errors"
+	"github.com/guregu/null"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"nkonev.name/chat/auth"
+	"nkonev.name/chat/db"
+	"nkonev.name/chat/dto"
+	. "nkonev.name/chat/logger"
+	"nkonev.name/chat/utils"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	searchGroupChats    = "chats"
+	searchGroupMessages = "messages"
+	searchGroupUsers    = "users"
+)
+
+var errWrongCursor = errors.New("Wrong cursor")
+
+// is used by the chat list as well
+type chatCursor struct {
+	Pinned             bool      `json:"pinned,omitempty"` // only the chat list puts the pinned chats first
+	LastUpdateDateTime time.Time `json:"lastUpdateDateTime"`
+	Id                 int64     `json:"id"`
+}
+
+type messageSearchCursor struct {
+	Rank           float32   `json:"rank"`
+	CreateDateTime time.Time `json:"createDateTime"`
+	ChatId         int64     `json:"chatId"`
+	Id             int64     `json:"id"`
+}
+
+type userSearchCursor struct {
+	Id int64 `json:"id"`
+}
+
+// Search looks for chat titles, messages and participant logins across all the user's chats.
+// Without group the first page of each group is returned, the next pages are requested by group and its cursor.
+func (ch *ChatHandler) Search(c echo.Context) error {
+	var userPrincipalDto, ok = c.Get(utils.USER_PRINCIPAL_DTO).(*auth.AuthResult)
+	if !ok {
+		GetLogEntry(c.Request().Context()).Errorf("Error during getting auth context")
+		return errors.New("Error during getting auth context")
+	}
+
+	size := utils.FixSizeString(c.QueryParam("size"))
+	group := c.QueryParam("group")
+	cursor := c.QueryParam("cursor")
+	searchString := strings.TrimSpace(TrimAmdSanitize(ch.policy, c.QueryParam("searchString")))
+
+	if group != "" && group != searchGroupChats && group != searchGroupMessages && group != searchGroupUsers {
+		return c.JSON(http.StatusBadRequest, &utils.H{"message": "Unknown group"})
+	}
+	if group == "" && cursor != "" {
+		return c.JSON(http.StatusBadRequest, &utils.H{"message": "Cursor requires group"})
+	}
+
+	var ret = &dto.GlobalSearchDto{}
+	if searchString == "" {
+		return c.JSON(http.StatusOK, ret)
+	}
+
+	var err error
+	if group == "" || group == searchGroupChats {
+		ret.Chats, err = ch.searchChats(userPrincipalDto.UserId, searchString, size, cursor)
+	}
+	if err == nil && (group == "" || group == searchGroupMessages) {
+		ret.Messages, err = ch.searchMessages(c, userPrincipalDto.UserId, searchString, size, cursor)
+	}
+	if err == nil && (group == "" || group == searchGroupUsers) {
+		ret.Users, err = ch.searchUsers(c, userPrincipalDto.UserId, searchString, size, cursor)
+	}
+	if errors.Is(err, errWrongCursor) {
+		return c.JSON(http.StatusBadRequest, &utils.H{"message": err.Error()})
+	} else if err != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error during global search %v", err)
+		return err
+	}
+
+	return c.JSON(http.StatusOK, ret)
+}
+
+func encodeNextCursor(key interface{}) (null.String, error) {
+	next, err := utils.EncodeCursor(key)
+	if err != nil {
+		return null.String{}, err
+	}
+	return null.StringFrom(next), nil
+}
+
+func (ch *ChatHandler) searchChats(userId int64, searchString string, size int, cursor string) (*dto.FoundChatsDto, error) {
+	var after *db.Chat
+	if cursor != "" {
+		var key chatCursor
+		if err := utils.DecodeCursor(cursor, &key); err != nil {
+			return nil, errWrongCursor
+		}
+		after = &db.Chat{LastUpdateDateTime: key.LastUpdateDateTime, Id: key.Id}
+	}
+
+	// one more to know is there the next page
+	chats, err := ch.db.SearchChatsAfter(userId, searchString, size+1, after)
+	if err != nil {
+		return nil, err
+	}
+	hasNext := len(chats) > size
+	if hasNext {
+		chats = chats[:size]
+	}
+
+	var ret = &dto.FoundChatsDto{Items: make([]*dto.FoundChatDto, 0)}
+	for _, chat := range chats {
+		ret.Items = append(ret.Items, &dto.FoundChatDto{
+			Id:                 chat.Id,
+			Name:               chat.Title,
+			Avatar:             chat.Avatar,
+			LastUpdateDateTime: chat.LastUpdateDateTime,
+		})
+	}
+	if hasNext {
+		last := chats[len(chats)-1]
+		ret.NextCursor, err = encodeNextCursor(chatCursor{LastUpdateDateTime: last.LastUpdateDateTime, Id: last.Id})
+	}
+	return ret, err
+}
+
+func (ch *ChatHandler) searchMessages(c echo.Context, userId int64, searchString string, size int, cursor string) (*dto.FoundMessagesDto, error) {
+	var after *db.FoundMessage
+	if cursor != "" {
+		var key messageSearchCursor
+		if err := utils.DecodeCursor(cursor, &key); err != nil {
+			return nil, errWrongCursor
+		}
+		after = &db.FoundMessage{Rank: key.Rank, CreateDateTime: key.CreateDateTime, ChatId: key.ChatId, Id: key.Id}
+	}
+
+	foundMessages, err := ch.db.SearchMessagesAfter(userId, searchString, size+1, after)
+	if err != nil {
+		return nil, err
+	}
+	hasNext := len(foundMessages) > size
+	if hasNext {
+		foundMessages = foundMessages[:size]
+	}
+
+	var ret = &dto.FoundMessagesDto{Items: convertToFoundMessageDtos(c, &ch.db, ch.restClient, foundMessages)}
+	if hasNext {
+		last := foundMessages[len(foundMessages)-1]
+		ret.NextCursor, err = encodeNextCursor(messageSearchCursor{Rank: last.Rank, CreateDateTime: last.CreateDateTime, ChatId: last.ChatId, Id: last.Id})
+	}
+	return ret, err
+}
+
+// aaa searches among all the users, so we leave only ones who have common chats with the current user
+func (ch *ChatHandler) searchUsers(c echo.Context, userId int64, searchString string, size int, cursor string) (*dto.FoundUsersDto, error) {
+	var afterId int64 = 0
+	if cursor != "" {
+		var key userSearchCursor
+		if err := utils.DecodeCursor(cursor, &key); err != nil {
+			return nil, errWrongCursor
+		}
+		afterId = key.Id
+	}
+
+	users, err := ch.restClient.SearchGetUsers(searchString, []int64{userId}, c.Request().Context())
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(users, func(i, j int) bool {
+		return users[i].Id < users[j].Id
+	})
+	var userIds = []int64{}
+	for _, user := range users {
+		userIds = append(userIds, user.Id)
+	}
+	commonChatIds, err := ch.db.GetCommonChatIds(userId, userIds)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret = &dto.FoundUsersDto{Items: make([]*dto.FoundUserDto, 0)}
+	hasNext := false
+	for _, user := range users {
+		chatIds, ok := commonChatIds[user.Id]
+		if !ok || user.Id == userId || user.Id <= afterId {
+			continue
+		}
+		if len(ret.Items) == size {
+			hasNext = true
+			break
+		}
+		ret.Items = append(ret.Items, &dto.FoundUserDto{
+			User:    user,
+			ChatIds: chatIds,
+		})
+	}
+	if hasNext {
+		last := ret.Items[len(ret.Items)-1]
+		ret.NextCursor, err = encodeNextCursor(userSearchCursor{Id: last.User.Id})
+	}
+	return ret, err
+}
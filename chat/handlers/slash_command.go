@@ -0,0 +1,245 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	strip "github.com/grokify/html-strip-tags-go"
+	"github.com/labstack/echo/v4"
+	"html"
+	"net/http"
+	"nkonev.name/chat/auth"
+	"nkonev.name/chat/db"
+	"nkonev.name/chat/dto"
+	. "nkonev.name/chat/logger"
+	"nkonev.name/chat/utils"
+	"strings"
+	"time"
+)
+
+type SlashCommandDto struct {
+	Name        string `json:"name"`
+	Usage       string `json:"usage"`
+	Description string `json:"description"`
+}
+
+type SlashCommandResultDto struct {
+	Command string `json:"command"`
+}
+
+// slashCommandError is shown to the user who has typed the command
+type slashCommandError string
+
+func (e slashCommandError) Error() string {
+	return string(e)
+}
+
+type slashCommand struct {
+	name        string
+	usage       string
+	description string
+	permission  dto.Permission
+	// execute runs in the transaction instead of saving the message, args are the plain text after the name
+	execute func(mc *MessageHandler, c echo.Context, tx *db.Tx, userPrincipalDto *auth.AuthResult, chatId int64, args string) error
+	// afterCommit is optional and calls the other services, so they see the committed changes and don't hold the transaction
+	afterCommit func(mc *MessageHandler, c echo.Context, userPrincipalDto *auth.AuthResult, chatId int64) error
+}
+
+var slashCommands = []*slashCommand{
+	{
+		name:        "invite",
+		usage:       "/invite @login",
+		description: "Adds the mentioned users to the chat",
+		permission:  dto.PermissionManageParticipants,
+		execute:     (*MessageHandler).inviteCommand,
+	},
+	{
+		name:        "call",
+		usage:       "/call",
+		description: "Starts the video call and dials the participants",
+		permission:  dto.PermissionWriteMessage,
+		execute:     (*MessageHandler).callCommand,
+		afterCommit: (*MessageHandler).dialCommand,
+	},
+	{
+		name:        "topic",
+		usage:       "/topic New title",
+		description: "Renames the chat",
+		permission:  dto.PermissionEditChat,
+		execute:     (*MessageHandler).topicCommand,
+	},
+	{
+		name:        "remind",
+		usage:       "/remind 10m text",
+		description: "Posts the text after the delay, e.g. 90s, 10m or 2h",
+		permission:  dto.PermissionWriteMessage,
+		execute:     (*MessageHandler).remindCommand,
+	},
+}
+
+func findSlashCommand(name string) *slashCommand {
+	for _, command := range slashCommands {
+		if command.name == name {
+			return command
+		}
+	}
+	return nil
+}
+
+// parseSlashCommand takes the command from the message's html, e.g. "<p>/topic New title</p>" gives "topic" and "New title"
+func parseSlashCommand(text string) (string, string, bool) {
+	plain := strings.TrimSpace(html.UnescapeString(strip.StripTags(text)))
+	if !strings.HasPrefix(plain, "/") {
+		return "", "", false
+	}
+	name, args, _ := strings.Cut(plain[1:], " ")
+	return strings.ToLower(name), strings.TrimSpace(args), true
+}
+
+// executeSlashCommand writes the response, the unknown commands don't reach here and are saved as the regular text
+func (mc *MessageHandler) executeSlashCommand(c echo.Context, command *slashCommand, userPrincipalDto *auth.AuthResult, chatId int64, args string) error {
+	errOuter := db.Transact(mc.db, func(tx *db.Tx) error {
+		// the command is typed as a message, so the channel's readers can't use them
+		if permitted, err := canWriteMessage(tx, userPrincipalDto.UserId, chatId); err != nil {
+			return err
+		} else if !permitted {
+			return slashCommandError("You are not allowed to write to this chat")
+		}
+		if permitted, err := hasPermission(tx, userPrincipalDto.UserId, chatId, command.permission); err != nil {
+			return err
+		} else if !permitted {
+			return slashCommandError(fmt.Sprintf("You are not allowed to use /%v in this chat", command.name))
+		}
+		return command.execute(mc, c, tx, userPrincipalDto, chatId, args)
+	})
+	if errOuter == nil && command.afterCommit != nil {
+		errOuter = command.afterCommit(mc, c, userPrincipalDto, chatId)
+	}
+	var commandError slashCommandError
+	if errors.As(errOuter, &commandError) {
+		return c.JSON(http.StatusBadRequest, &utils.H{"message": commandError.Error()})
+	}
+	if errOuter != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error during act transaction %v", errOuter)
+		return errOuter
+	}
+	return c.JSON(http.StatusOK, &SlashCommandResultDto{Command: command.name})
+}
+
+func (mc *MessageHandler) inviteCommand(c echo.Context, tx *db.Tx, userPrincipalDto *auth.AuthResult, chatId int64, args string) error {
+	logins := findMentionedLogins(args)
+	if len(logins) == 0 {
+		return slashCommandError("Mention the users to invite, e.g. /invite @bob")
+	}
+	participantIds, err := tx.GetAllParticipantIds(chatId)
+	if err != nil {
+		return err
+	}
+	var newParticipantIds = []int64{}
+	for _, login := range logins {
+		// the participants are excluded from the search
+		users, err := mc.restClient.SearchGetUsers(login, participantIds, c.Request().Context())
+		if err != nil {
+			return err
+		}
+		var found *dto.User
+		for _, user := range users {
+			if strings.EqualFold(user.Login, login) {
+				found = user
+				break
+			}
+		}
+		if found == nil {
+			return slashCommandError(fmt.Sprintf("User %v is not found or is already in the chat", login))
+		}
+		newParticipantIds = append(newParticipantIds, found.Id)
+	}
+	return mc.chatHandler.addParticipants(c, tx, userPrincipalDto, chatId, newParticipantIds, 0, utils.FixSize(0), 0)
+}
+
+// callCommand has only the checks of executeSlashCommand, the participants are dialed by dialCommand
+func (mc *MessageHandler) callCommand(c echo.Context, tx *db.Tx, userPrincipalDto *auth.AuthResult, chatId int64, args string) error {
+	return nil
+}
+
+func (mc *MessageHandler) dialCommand(c echo.Context, userPrincipalDto *auth.AuthResult, chatId int64) error {
+	if err := mc.restClient.Dial(chatId, userPrincipalDto.UserId, c.Request().Context()); err != nil {
+		GetLogEntry(c.Request().Context()).Warnf("Error during dialing in chat %v %v", chatId, err)
+		return slashCommandError("Unable to start the call")
+	}
+	return nil
+}
+
+func (mc *MessageHandler) topicCommand(c echo.Context, tx *db.Tx, userPrincipalDto *auth.AuthResult, chatId int64, args string) error {
+	chat, err := tx.GetChat(userPrincipalDto.UserId, chatId)
+	if err != nil {
+		return err
+	}
+	if chat == nil {
+		return slashCommandError("Chat is not found")
+	}
+	if chat.TetATet {
+		return slashCommandError("Tet-a-tet chat can't be renamed")
+	}
+	bindTo := &EditChatDto{
+		Id: chatId,
+		CreateChatDto: CreateChatDto{
+			Name:      args,
+			Avatar:    chat.Avatar,
+			AvatarBig: chat.AvatarBig,
+		},
+	}
+	if err := bindTo.Validate(); err != nil {
+		return slashCommandError("Usage: /topic New title")
+	}
+	_, err = mc.chatHandler.editChat(c, tx, userPrincipalDto, bindTo)
+	return err
+}
+
+func (mc *MessageHandler) remindCommand(c echo.Context, tx *db.Tx, userPrincipalDto *auth.AuthResult, chatId int64, args string) error {
+	delayString, text, _ := strings.Cut(args, " ")
+	delay, err := time.ParseDuration(delayString)
+	// the text is unescaped during the parsing
+	text = TrimAmdSanitize(mc.policy, html.EscapeString(strings.TrimSpace(text)))
+	if err != nil || delay <= 0 || text == "" {
+		return slashCommandError("Usage: /remind 10m text")
+	}
+	_, _, err = tx.CreateScheduledMessage(&db.ScheduledMessage{
+		ChatId:  chatId,
+		OwnerId: userPrincipalDto.UserId,
+		Text:    text,
+		SendAt:  time.Now().Add(delay).UTC(),
+	})
+	return err
+}
+
+// GetSlashCommands lists the commands the user can use in the chat, searchString filters them by the beginning of the name
+func (mc *MessageHandler) GetSlashCommands(c echo.Context) error {
+	var userPrincipalDto, ok = c.Get(utils.USER_PRINCIPAL_DTO).(*auth.AuthResult)
+	if !ok {
+		GetLogEntry(c.Request().Context()).Errorf("Error during getting auth context")
+		return errors.New("Error during getting auth context")
+	}
+
+	chatId, err := GetPathParamAsInt64(c, "id")
+	if err != nil {
+		return err
+	}
+	searchString := strings.ToLower(strings.TrimPrefix(c.QueryParam("searchString"), "/"))
+
+	role, err := mc.db.GetParticipantRole(userPrincipalDto.UserId, chatId)
+	if err != nil {
+		return err
+	}
+
+	commandDtos := make([]*SlashCommandDto, 0)
+	for _, command := range slashCommands {
+		if dto.HasPermission(role, command.permission) && strings.HasPrefix(command.name, searchString) {
+			commandDtos = append(commandDtos, &SlashCommandDto{
+				Name:        command.name,
+				Usage:       command.usage,
+				Description: command.description,
+			})
+		}
+	}
+	return c.JSON(http.StatusOK, commandDtos)
+}
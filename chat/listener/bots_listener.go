@@ -0,0 +1,78 @@
+package listener
+
+import (
+	"encoding/json"
+	"github.com/beliyav/go-amqp-reconnect/rabbitmq"
+	"github.com/streadway/amqp"
+	"go.uber.org/fx"
+	"nkonev.name/chat/db"
+	"nkonev.name/chat/dto"
+	. "nkonev.name/chat/logger"
+	"nkonev.name/chat/producer"
+	myRabbit "nkonev.name/chat/rabbitmq"
+	"nkonev.name/chat/utils"
+)
+
+// the durable queue is shared among the instances of chat, so every event is stored once
+const botsQueue = "chat-bots"
+
+type BotsQueue struct{ *amqp.Queue }
+
+type BotsChannel struct{ *rabbitmq.Channel }
+
+type BotsListener func(*amqp.Delivery) error
+
+// addressedEvent is the common part of ChatEvent and GlobalEvent
+type addressedEvent struct {
+	EventType string `json:"eventType"`
+	UserId    int64  `json:"userId"`
+}
+
+func CreateBotsChannel(connection *rabbitmq.Connection) BotsChannel {
+	return BotsChannel{myRabbit.CreateRabbitMqChannel(connection)}
+}
+
+func CreateBotsQueue(consumeCh BotsChannel) BotsQueue {
+	err := consumeCh.ExchangeDeclare(producer.AsyncEventsFanoutExchange, "fanout", true, false, false, false, nil)
+	if err != nil {
+		Logger.Warnf("Unable to declare exchange %v, restarting. error %v", producer.AsyncEventsFanoutExchange, err)
+		Logger.Panic(err)
+	}
+	q := create(botsQueue, consumeCh.Channel)
+	err = consumeCh.QueueBind(q.Name, "", producer.AsyncEventsFanoutExchange, false, nil)
+	if err != nil {
+		Logger.Warnf("Unable to bind queue %v, restarting. error %v", q.Name, err)
+		Logger.Panic(err)
+	}
+	return BotsQueue{q}
+}
+
+// CreateBotsListener stores the events addressed to the bots, the bots take them with the long polling
+func CreateBotsListener(dbR db.DB) BotsListener {
+	return func(msg *amqp.Delivery) error {
+		if msg.Type != utils.GetType(dto.ChatEvent{}) && msg.Type != utils.GetType(dto.GlobalEvent{}) {
+			return nil
+		}
+		var event addressedEvent
+		if err := json.Unmarshal(msg.Body, &event); err != nil {
+			Logger.Errorf("Error during deserialize %v %v", msg.Type, err)
+			return nil
+		}
+		if !db.IsBotOwnerId(event.UserId) {
+			return nil
+		}
+		if err := dbR.AddBotEvent(-event.UserId, event.EventType, string(msg.Body)); err != nil {
+			Logger.Errorf("Error during storing event for bot %v %v", -event.UserId, err)
+		}
+		return nil
+	}
+}
+
+func ListenBotsQueue(
+	channel BotsChannel,
+	queue BotsQueue,
+	onMessage BotsListener,
+	lc fx.Lifecycle) {
+
+	listen(channel.Channel, queue.Queue, onMessage, lc)
+}
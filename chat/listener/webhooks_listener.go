@@ -0,0 +1,54 @@
+package listener
+
+import (
+	"encoding/json"
+	"github.com/beliyav/go-amqp-reconnect/rabbitmq"
+	"github.com/streadway/amqp"
+	"go.uber.org/fx"
+	"nkonev.name/chat/dto"
+	. "nkonev.name/chat/logger"
+	"nkonev.name/chat/producer"
+	myRabbit "nkonev.name/chat/rabbitmq"
+	"nkonev.name/chat/services"
+	"nkonev.name/chat/utils"
+)
+
+type WebhooksQueue struct{ *amqp.Queue }
+
+type WebhooksChannel struct{ *rabbitmq.Channel }
+
+type WebhooksListener func(*amqp.Delivery) error
+
+func CreateWebhooksChannel(connection *rabbitmq.Connection) WebhooksChannel {
+	return WebhooksChannel{myRabbit.CreateRabbitMqChannel(connection)}
+}
+
+// CreateWebhooksQueue declares the queue RabbitFanoutNotificationsPublisher.PublishToWebhooks sends to
+func CreateWebhooksQueue(consumeCh WebhooksChannel) WebhooksQueue {
+	return WebhooksQueue{create(producer.WebhooksQueue, consumeCh.Channel)}
+}
+
+// CreateWebhooksListener passes the events as is to the webhooks of their chats
+func CreateWebhooksListener(dispatcher *services.WebhookDispatcher) WebhooksListener {
+	return func(msg *amqp.Delivery) error {
+		if msg.Type != utils.GetType(dto.WebhookEvent{}) {
+			return nil
+		}
+		var event dto.WebhookEvent
+		if err := json.Unmarshal(msg.Body, &event); err != nil {
+			Logger.Errorf("Error during deserialize WebhookEvent %v", err)
+			return nil
+		}
+		dispatcher.Dispatch(event.ChatId, event.EventType, msg.Body)
+		return nil
+	}
+}
+
+func ListenWebhooksQueue(
+	channel WebhooksChannel,
+	queue WebhooksQueue,
+	onMessage WebhooksListener,
+	lc fx.Lifecycle) {
+
+	listen(channel.Channel, queue.Queue, onMessage, lc)
+}
@@ -23,6 +23,7 @@ import (
 	. "nkonev.name/chat/logger"
 	"nkonev.name/chat/producer"
 	"nkonev.name/chat/rabbitmq"
+	"nkonev.name/chat/redis"
 	"nkonev.name/chat/services"
 )
 
@@ -46,16 +47,33 @@ func main() {
 			configureMigrations,
 			db.ConfigureDb,
 			services.NewNotifications,
+			services.NewLinkPreviewFetcher,
 			producer.NewRabbitNotificationsPublisher,
 			listener.CreateAaaUserProfileUpdateListener,
 			rabbitmq.CreateRabbitMqConnection,
 			listener.CreateAaaChannel,
 			listener.CreateAaaQueue,
+			services.NewWebhookDispatcher,
+			listener.CreateWebhooksListener,
+			listener.CreateWebhooksChannel,
+			listener.CreateWebhooksQueue,
+			listener.CreateBotsListener,
+			listener.CreateBotsChannel,
+			listener.CreateBotsQueue,
+			redis.RedisV8,
+			redis.NewSendScheduledMessagesService,
+			redis.SendScheduledMessagesScheduler,
+			redis.NewPurgeDeletedChatsService,
+			redis.PurgeDeletedChatsScheduler,
 		),
 		fx.Invoke(
 			runMigrations,
 			runEcho,
 			listener.ListenAaaQueue,
+			listener.ListenWebhooksQueue,
+			listener.ListenBotsQueue,
+			runScheduler,
+			runPurgeDeletedChatsScheduler,
 		),
 	)
 	app.Run()
@@ -128,12 +146,35 @@ func configureEcho(
 	e.Use(middleware.BodyLimit(bodyLimit))
 
 	e.GET("/chat", ch.GetChats)
+	e.GET("/chat/search", ch.Search)
+	e.GET("/chat/public", ch.GetPublicChats)
+	e.POST("/chat/bot", ch.CreateBot)
+	e.GET("/chat/bot", ch.GetBots)
+	e.PUT("/chat/bot/:botId/token", ch.RegenerateBotToken)
+	e.GET("/chat/bot/events", ch.GetBotEvents)
+	e.POST("/chat/join/:token", ch.JoinChatByInvite)
 	e.GET("/chat/:id", ch.GetChat)
 	e.POST("/chat", ch.CreateChat)
 	e.DELETE("/chat/:id", ch.DeleteChat)
+	e.POST("/chat/:id/restore", ch.RestoreChat)
+	e.POST("/chat/:id/webhooks", ch.CreateChatWebhook)
+	e.GET("/chat/:id/webhooks", ch.GetChatWebhooks)
+	e.DELETE("/chat/:id/webhooks/:hookId", ch.DeleteChatWebhook)
+	e.GET("/chat/:id/webhooks/:hookId/deliveries", ch.GetChatWebhookDeliveries)
+	e.POST("/chat/:id/incoming-webhooks", ch.CreateIncomingWebhook)
+	e.GET("/chat/:id/incoming-webhooks", ch.GetIncomingWebhooks)
+	e.DELETE("/chat/:id/incoming-webhooks/:token", ch.DeleteIncomingWebhook)
 	e.PUT("/chat", ch.EditChat)
 	e.PUT("/chat/:id/leave", ch.LeaveChat)
+	e.GET("/chat/:id/settings", ch.GetChatSettings)
+	e.PUT("/chat/:id/settings", ch.PutChatSettings)
+	e.PUT("/chat/:id/pin", ch.PinChat)
+	e.PUT("/chat/:id/join", ch.JoinPublicChat)
+	e.POST("/chat/:id/invite", ch.CreateChatInvite)
+	e.GET("/chat/:id/invite", ch.GetChatInvites)
+	e.DELETE("/chat/:id/invite/:token", ch.DeleteChatInvite)
 	e.PUT("/chat/:id/user/:participantId", ch.ChangeParticipant)
+	e.PUT("/chat/:id/owner/:userId", ch.TransferOwnership)
 	e.DELETE("/chat/:id/user/:participantId", ch.DeleteParticipant)
 	e.DELETE("/internal/delete-all-participants", ch.RemoveAllParticipants)
 	e.GET("/internal/does-participant-belong-to-chat", ch.DoesParticipantBelongToChat)
@@ -147,12 +188,28 @@ func configureEcho(
 	e.GET("/internal/name-for-invite", ch.GetNameForInvite)
 
 	e.GET("/chat/:id/message", mc.GetMessages)
+	e.GET("/chat/:id/message/pinned", mc.GetPinnedMessages)
+	e.GET("/chat/:id/message/deleted", mc.GetDeletedMessages)
 	e.GET("/chat/:id/message/:messageId", mc.GetMessage)
+	e.GET("/chat/:id/message/:messageId/thread", mc.GetThread)
+	e.GET("/chat/:id/message/:messageId/readers", mc.GetMessageReaders)
+	e.GET("/chat/:id/message/:messageId/history", mc.GetMessageHistory)
+	e.PUT("/chat/:id/message/:messageId/pin", mc.PinMessage)
+	e.PUT("/chat/:id/message/:messageId/reaction", mc.PutReaction)
+	e.DELETE("/chat/:id/message/:messageId/reaction", mc.DeleteReaction)
 	e.POST("/chat/:id/message", mc.PostMessage)
+	e.GET("/chat/:id/commands", mc.GetSlashCommands)
+	e.POST("/chat/hooks/:token", mc.PostIncomingWebhookMessage)
+	e.POST("/chat/:id/message/:messageId/forward", mc.ForwardMessage)
 	e.PUT("/chat/:id/message", mc.EditMessage)
 	e.DELETE("/chat/:id/message/:messageId", mc.DeleteMessage)
 	e.PUT("/chat/:id/message/read/:messageId", mc.ReadMessage)
+	e.POST("/chat/:id/message/scheduled", mc.PostScheduledMessage)
+	e.GET("/chat/:id/message/scheduled", mc.GetScheduledMessages)
+	e.DELETE("/chat/:id/message/scheduled/:scheduledMessageId", mc.CancelScheduledMessage)
 	e.PUT("/chat/message/check-for-new", mc.CheckForNew)
+	e.GET("/chat/message/search", mc.SearchMessages)
+	e.GET("/chat/mentions", mc.GetMentions)
 	e.PUT("/chat/:id/typing", mc.TypeMessage)
 	e.PUT("/chat/:id/broadcast", mc.BroadcastMessage)
 	e.DELETE("/internal/remove-file-item", mc.RemoveFileItem)
@@ -213,6 +270,28 @@ func runMigrations(db db.DB, migrationsConfig db.MigrationsConfig) {
 	db.Migrate(migrationsConfig)
 }
 
+func runScheduler(sendScheduledMessagesTask *redis.SendScheduledMessagesTask) {
+	go func() {
+		err := sendScheduledMessagesTask.Run(context.Background())
+		if err != nil {
+			Logger.Errorf("Error during working sendScheduledMessagesTask: %s", err)
+		}
+	}()
+
+	Logger.Infof("Scheduled messages scheduler is started")
+}
+
+func runPurgeDeletedChatsScheduler(purgeDeletedChatsTask *redis.PurgeDeletedChatsTask) {
+	go func() {
+		err := purgeDeletedChatsTask.Run(context.Background())
+		if err != nil {
+			Logger.Errorf("Error during working purgeDeletedChatsTask: %s", err)
+		}
+	}()
+
+	Logger.Infof("Purge deleted chats scheduler is started")
+}
+
 // rely on viper import and it's configured by
 func runEcho(e *echo.Echo) {
 	address := viper.GetString("server.address")
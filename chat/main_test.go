@@ -2,6 +2,9 @@ package main
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"github.com/beliyav/go-amqp-reconnect/rabbitmq"
@@ -9,6 +12,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/oliveagle/jsonpath"
 	"github.com/spf13/viper"
+	"github.com/streadway/amqp"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/fx"
 	"go.uber.org/fx/fxtest"
@@ -22,6 +26,7 @@ import (
 	"nkonev.name/chat/db"
 	"nkonev.name/chat/dto"
 	"nkonev.name/chat/handlers"
+	"nkonev.name/chat/listener"
 	. "nkonev.name/chat/logger"
 	"nkonev.name/chat/producer"
 	myRabbitmq "nkonev.name/chat/rabbitmq"
@@ -29,6 +34,7 @@ import (
 	"nkonev.name/chat/utils"
 	"os"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -196,7 +202,7 @@ func configureTestMigrations() db.MigrationsConfig {
 	return db.MigrationsConfig{AppendTestData: true}
 }
 
-func runTest(t *testing.T, testFunc interface{}) *fxtest.App {
+func runTest(t testing.TB, testFunc interface{}) *fxtest.App {
 	var s fx.Shutdowner
 	app := fxtest.New(
 		t,
@@ -214,6 +220,7 @@ func runTest(t *testing.T, testFunc interface{}) *fxtest.App {
 			configureTestMigrations,
 			db.ConfigureDb,
 			services.NewNotifications,
+			services.NewLinkPreviewFetcher,
 			producer.NewRabbitNotificationsPublisher,
 			myRabbitmq.CreateRabbitMqConnection,
 		),
@@ -246,6 +253,7 @@ func startAppFull(t *testing.T) (*fxtest.App, fx.Shutdowner) {
 			configureTestMigrations,
 			db.ConfigureDb,
 			services.NewNotifications,
+			services.NewLinkPreviewFetcher,
 			producer.NewRabbitNotificationsPublisher,
 			myRabbitmq.CreateRabbitMqConnection,
 		),
@@ -280,13 +288,13 @@ func TestGetChats(t *testing.T) {
 	})
 }
 
-func getJsonPathResult(t *testing.T, body string, jsonpath0 string) interface{} {
+func getJsonPathResult(t testing.TB, body string, jsonpath0 string) interface{} {
 	res := getJsonPathRaw(t, body, jsonpath0)
 	assert.NotEmpty(t, res)
 	return res
 }
 
-func getJsonPathRaw(t *testing.T, body string, jsonpath0 string) interface{} {
+func getJsonPathRaw(t testing.TB, body string, jsonpath0 string) interface{} {
 	var jsonData interface{}
 	assert.Nil(t, json.Unmarshal([]byte(body), &jsonData))
 	res, err := jsonpath.JsonPathLookup(jsonData, jsonpath0)
@@ -321,6 +329,34 @@ func TestGetChatsPaginated(t *testing.T) {
 	})
 }
 
+func TestGetChatsByCursor(t *testing.T) {
+	emu := startAaaEmu()
+	defer emu.Close()
+	runTest(t, func(e *echo.Echo) {
+		c0, b0, _ := request("GET", "/chat?size=6", nil, e)
+		assert.Equal(t, http.StatusOK, c0)
+		initialNames := getJsonPathResult(t, b0, "$.data.name").([]interface{})
+		assert.Equal(t, 6, len(initialNames))
+
+		c1, b1, _ := request("GET", "/chat?size=3", nil, e)
+		assert.Equal(t, http.StatusOK, c1)
+		assert.Equal(t, initialNames[:3], getJsonPathResult(t, b1, "$.data.name").([]interface{}))
+		cursor := getJsonPathResult(t, b1, "$.nextCursor").(string)
+
+		// a new message bumps the already seen chat, with page=2 the next page would start from the third chat again
+		bumpedChatIdString := interfaceToString(getJsonPathResult(t, b1, "$.data[2].id").(interface{}))
+		c2, _, _ := request("POST", "/chat/"+bumpedChatIdString+"/message", strings.NewReader(`{"text": "bump"}`), e)
+		assert.Equal(t, http.StatusCreated, c2)
+
+		c3, b3, _ := request("GET", "/chat?size=3&cursor="+url.QueryEscape(cursor), nil, e)
+		assert.Equal(t, http.StatusOK, c3)
+		assert.Equal(t, initialNames[3:6], getJsonPathResult(t, b3, "$.data.name").([]interface{}))
+
+		c4, _, _ := request("GET", "/chat?size=3&cursor=wrong", nil, e)
+		assert.Equal(t, http.StatusBadRequest, c4)
+	})
+}
+
 func TestChatValidation(t *testing.T) {
 	runTest(t, func(e *echo.Echo, db db.DB) {
 		c, b, _ := request("POST", "/chat", strings.NewReader(`{"name": ""}`), e)
@@ -602,3 +638,1391 @@ func TestItIsNotPossibleToWriteToForeignChat(t *testing.T) {
 		assert.Equal(t, "You are not allowed to write to this chat", messageString)
 	})
 }
+
+func TestMessageReplyAndThread(t *testing.T) {
+	runTest(t, func(e *echo.Echo) {
+		c, b, _ := request("POST", "/chat/1/message", strings.NewReader(`{"text": "Root message"}`), e)
+		assert.Equal(t, http.StatusCreated, c)
+		rootIdString := interfaceToString(getJsonPathResult(t, b, "$.id").(interface{}))
+
+		c1, b1, _ := request("POST", "/chat/1/message", strings.NewReader(`{"text": "<b>First</b> reply", "replyToMessageId": `+rootIdString+`}`), e)
+		assert.Equal(t, http.StatusCreated, c1)
+		firstReplyIdString := interfaceToString(getJsonPathResult(t, b1, "$.id").(interface{}))
+		assert.Equal(t, rootIdString, interfaceToString(getJsonPathResult(t, b1, "$.replyToMessageId").(interface{})))
+		assert.Equal(t, "Root message", interfaceToString(getJsonPathResult(t, b1, "$.replyTo.text").(interface{})))
+
+		c2, _, _ := request("POST", "/chat/1/message", strings.NewReader(`{"text": "Nested reply", "replyToMessageId": `+firstReplyIdString+`}`), e)
+		assert.Equal(t, http.StatusCreated, c2)
+
+		c3, b3, _ := request("GET", "/chat/1/message/"+rootIdString+"/thread", nil, e)
+		assert.Equal(t, http.StatusOK, c3)
+		texts := getJsonPathResult(t, b3, "$.text").([]interface{})
+		assert.Equal(t, 2, len(texts))
+		assert.Equal(t, "<b>First</b> reply", texts[0])
+		assert.Equal(t, "Nested reply", texts[1])
+		replyTexts := getJsonPathResult(t, b3, "$.replyTo.text").([]interface{})
+		assert.Equal(t, "First reply", replyTexts[1])
+
+		c4, b4, _ := request("POST", "/chat/1/message", strings.NewReader(`{"text": "Reply to nowhere", "replyToMessageId": 100500}`), e)
+		assert.Equal(t, http.StatusBadRequest, c4)
+		assert.Equal(t, "Message to reply is not found", interfaceToString(getJsonPathResult(t, b4, "$.message").(interface{})))
+
+		c5, _, _ := request("GET", "/chat/1/message/100500/thread", nil, e)
+		assert.Equal(t, http.StatusNotFound, c5)
+	})
+}
+
+func TestMessageReactions(t *testing.T) {
+	runTest(t, func(e *echo.Echo) {
+		c, b, _ := request("POST", "/chat/1/message", strings.NewReader(`{"text": "Message to react"}`), e)
+		assert.Equal(t, http.StatusCreated, c)
+		messageIdString := interfaceToString(getJsonPathResult(t, b, "$.id").(interface{}))
+		assert.Equal(t, 0, len(getJsonPathRaw(t, b, "$.reactions").([]interface{})))
+
+		c1, b1, _ := request("PUT", "/chat/1/message/"+messageIdString+"/reaction", strings.NewReader(`{"reaction": "👍"}`), e)
+		assert.Equal(t, http.StatusOK, c1)
+		assert.Equal(t, messageIdString, interfaceToString(getJsonPathResult(t, b1, "$.messageId").(interface{})))
+		assert.Equal(t, "👍", interfaceToString(getJsonPathResult(t, b1, "$.reactions[0].reaction").(interface{})))
+		assert.Equal(t, "1", interfaceToString(getJsonPathResult(t, b1, "$.reactions[0].count").(interface{})))
+
+		// the same user replaces the own reaction
+		c2, b2, _ := request("PUT", "/chat/1/message/"+messageIdString+"/reaction", strings.NewReader(`{"reaction": "🔥"}`), e)
+		assert.Equal(t, http.StatusOK, c2)
+		reactions := getJsonPathResult(t, b2, "$.reactions").([]interface{})
+		assert.Equal(t, 1, len(reactions))
+		assert.Equal(t, "🔥", interfaceToString(getJsonPathResult(t, b2, "$.reactions[0].reaction").(interface{})))
+
+		c3, b3, _ := request("GET", "/chat/1/message/"+messageIdString, nil, e)
+		assert.Equal(t, http.StatusOK, c3)
+		assert.Equal(t, "🔥", interfaceToString(getJsonPathResult(t, b3, "$.reactions[0].reaction").(interface{})))
+
+		c4, b4, _ := request("DELETE", "/chat/1/message/"+messageIdString+"/reaction", nil, e)
+		assert.Equal(t, http.StatusOK, c4)
+		assert.Equal(t, 0, len(getJsonPathRaw(t, b4, "$.reactions").([]interface{})))
+
+		c5, _, _ := request("PUT", "/chat/1/message/"+messageIdString+"/reaction", strings.NewReader(`{"reaction": ""}`), e)
+		assert.Equal(t, http.StatusBadRequest, c5)
+
+		c6, _, _ := request("PUT", "/chat/1/message/100500/reaction", strings.NewReader(`{"reaction": "👍"}`), e)
+		assert.Equal(t, http.StatusNotFound, c6)
+	})
+}
+
+func TestPinMessage(t *testing.T) {
+	h2 := map[string][]string{
+		echo.HeaderContentType: {"application/json"},
+		"X-Auth-Expiresin":     {"1590022342295000"},
+		"X-Auth-Username":      {"dGVzdGVyMg=="}, // tester2
+		"X-Auth-Userid":        {"2"},
+	}
+
+	runTest(t, func(e *echo.Echo) {
+		c, b, _ := request("POST", "/chat/1/message", strings.NewReader(`{"text": "Important announcement"}`), e)
+		assert.Equal(t, http.StatusCreated, c)
+		messageIdString := interfaceToString(getJsonPathResult(t, b, "$.id").(interface{}))
+		assert.Equal(t, false, getJsonPathRaw(t, b, "$.pinned").(bool))
+
+		c1, b1, _ := request("PUT", "/chat/1/message/"+messageIdString+"/pin?pin=true", nil, e)
+		assert.Equal(t, http.StatusOK, c1)
+		assert.Equal(t, true, getJsonPathResult(t, b1, "$.pinned").(bool))
+
+		c2, b2, _ := request("GET", "/chat/1/message/pinned", nil, e)
+		assert.Equal(t, http.StatusOK, c2)
+		pinnedIds := getJsonPathResult(t, b2, "$.id").([]interface{})
+		assert.Equal(t, 1, len(pinnedIds))
+		assert.Equal(t, messageIdString, interfaceToString(pinnedIds[0]))
+
+		// tester2 isn't an admin of chat 1
+		c3, _, _ := requestWithHeader("PUT", "/chat/1/message/"+messageIdString+"/pin?pin=false", h2, nil, e)
+		assert.Equal(t, http.StatusUnauthorized, c3)
+
+		c4, b4, _ := request("PUT", "/chat/1/message/"+messageIdString+"/pin?pin=false", nil, e)
+		assert.Equal(t, http.StatusOK, c4)
+		assert.Equal(t, false, getJsonPathRaw(t, b4, "$.pinned").(bool))
+
+		c5, b5, _ := request("GET", "/chat/1/message/pinned", nil, e)
+		assert.Equal(t, http.StatusOK, c5)
+		assert.Equal(t, 0, len(getJsonPathRaw(t, b5, "$.id").([]interface{})))
+
+		c6, _, _ := request("PUT", "/chat/1/message/100500/pin?pin=true", nil, e)
+		assert.Equal(t, http.StatusNotFound, c6)
+	})
+}
+
+func TestSearchMessages(t *testing.T) {
+	h2 := map[string][]string{
+		echo.HeaderContentType: {"application/json"},
+		"X-Auth-Expiresin":     {"1590022342295000"},
+		"X-Auth-Username":      {"dGVzdGVyMg=="}, // tester2
+		"X-Auth-Userid":        {"2"},
+	}
+
+	runTest(t, func(e *echo.Echo) {
+		c, b, _ := request("POST", "/chat/1/message", strings.NewReader(`{"text": "The quick brown <i>foxes</i> were jumping over the lazy dog"}`), e)
+		assert.Equal(t, http.StatusCreated, c)
+		englishIdString := interfaceToString(getJsonPathResult(t, b, "$.id").(interface{}))
+
+		c1, b1, _ := request("POST", "/chat/2/message", strings.NewReader(`{"text": "Кошки бегали по крышам"}`), e)
+		assert.Equal(t, http.StatusCreated, c1)
+		russianIdString := interfaceToString(getJsonPathResult(t, b1, "$.id").(interface{}))
+
+		// stemming: fox -> foxes, jump -> jumping
+		c2, b2, _ := request("GET", "/chat/message/search?searchString="+url.QueryEscape("fox jump"), nil, e)
+		assert.Equal(t, http.StatusOK, c2)
+		ids := getJsonPathResult(t, b2, "$.id").([]interface{})
+		assert.Equal(t, 1, len(ids))
+		assert.Equal(t, englishIdString, interfaceToString(ids[0]))
+		assert.Equal(t, "1", interfaceToString(getJsonPathResult(t, b2, "$[0].chatId").(interface{})))
+		assert.Contains(t, interfaceToString(getJsonPathResult(t, b2, "$[0].highlight").(interface{})), "<b>foxes</b>")
+
+		c3, b3, _ := request("GET", "/chat/message/search?searchString="+url.QueryEscape("кошка бегать"), nil, e)
+		assert.Equal(t, http.StatusOK, c3)
+		ids3 := getJsonPathResult(t, b3, "$.id").([]interface{})
+		assert.Equal(t, 1, len(ids3))
+		assert.Equal(t, russianIdString, interfaceToString(ids3[0]))
+		assert.Equal(t, "2", interfaceToString(getJsonPathResult(t, b3, "$[0].chatId").(interface{})))
+
+		// the second user doesn't participate in these chats
+		c4, b4, _ := requestWithHeader("GET", "/chat/message/search?searchString="+url.QueryEscape("fox jump"), h2, nil, e)
+		assert.Equal(t, http.StatusOK, c4)
+		assert.Equal(t, 0, len(getJsonPathRaw(t, b4, "$.id").([]interface{})))
+
+		// search inside the chat uses the same index
+		c5, b5, _ := request("GET", "/chat/1/message?reverse=true&searchString="+url.QueryEscape("jumped"), nil, e)
+		assert.Equal(t, http.StatusOK, c5)
+		ids5 := getJsonPathResult(t, b5, "$.id").([]interface{})
+		assert.Equal(t, 1, len(ids5))
+		assert.Equal(t, englishIdString, interfaceToString(ids5[0]))
+	})
+}
+
+func TestGlobalSearch(t *testing.T) {
+	emu := startAaaEmu()
+	defer emu.Close()
+	runTest(t, func(e *echo.Echo) {
+		c, b, _ := request("POST", "/chat", strings.NewReader(`{"name": "Elephants lovers", "participantIds": [2]}`), e)
+		assert.Equal(t, http.StatusCreated, c)
+		chatIdString := interfaceToString(getJsonPathResult(t, b, "$.id").(interface{}))
+
+		c1, b1, _ := request("POST", "/chat/"+chatIdString+"/message", strings.NewReader(`{"text": "Elephants never forget"}`), e)
+		assert.Equal(t, http.StatusCreated, c1)
+		messageIdString := interfaceToString(getJsonPathResult(t, b1, "$.id").(interface{}))
+
+		c2, b2, _ := request("GET", "/chat/search?searchString=elephants", nil, e)
+		assert.Equal(t, http.StatusOK, c2)
+		chatIds := getJsonPathResult(t, b2, "$.chats.items[*].id").([]interface{})
+		assert.Equal(t, 1, len(chatIds))
+		assert.Equal(t, chatIdString, interfaceToString(chatIds[0]))
+		messageIds := getJsonPathResult(t, b2, "$.messages.items[*].id").([]interface{})
+		assert.Equal(t, 1, len(messageIds))
+		assert.Equal(t, messageIdString, interfaceToString(messageIds[0]))
+		assert.Nil(t, getJsonPathRaw(t, b2, "$.chats.nextCursor"))
+
+		// aaa emulator returns the both users, the current user is excluded
+		c3, b3, _ := request("GET", "/chat/search?group=users&searchString=testor", nil, e)
+		assert.Equal(t, http.StatusOK, c3)
+		assert.Nil(t, getJsonPathRaw(t, b3, "$.chats"))
+		userIds := getJsonPathResult(t, b3, "$.users.items[*].user.id").([]interface{})
+		assert.Equal(t, 1, len(userIds))
+		assert.Equal(t, "2", interfaceToString(userIds[0]))
+		commonChatIds := getJsonPathResult(t, b3, "$.users.items[0].chatIds").([]interface{})
+		assert.Equal(t, chatIdString, interfaceToString(commonChatIds[0]))
+
+		// cursor pagination
+		c4, b4, _ := request("GET", "/chat/search?group=chats&size=1&searchString=generated_chat", nil, e)
+		assert.Equal(t, http.StatusOK, c4)
+		firstPageId := interfaceToString(getJsonPathResult(t, b4, "$.chats.items[0].id").(interface{}))
+		cursor := interfaceToString(getJsonPathResult(t, b4, "$.chats.nextCursor").(interface{}))
+
+		c5, b5, _ := request("GET", "/chat/search?group=chats&size=1&searchString=generated_chat&cursor="+url.QueryEscape(cursor), nil, e)
+		assert.Equal(t, http.StatusOK, c5)
+		secondPageId := interfaceToString(getJsonPathResult(t, b5, "$.chats.items[0].id").(interface{}))
+		assert.NotEqual(t, firstPageId, secondPageId)
+
+		c6, _, _ := request("GET", "/chat/search?group=chats&searchString=generated_chat&cursor=wrong", nil, e)
+		assert.Equal(t, http.StatusBadRequest, c6)
+
+		c7, _, _ := request("GET", "/chat/search?searchString=generated_chat&cursor="+url.QueryEscape(cursor), nil, e)
+		assert.Equal(t, http.StatusBadRequest, c7)
+	})
+}
+
+func TestMessageReaders(t *testing.T) {
+	h2 := map[string][]string{
+		echo.HeaderContentType: {"application/json"},
+		"X-Auth-Expiresin":     {"1590022342295000"},
+		"X-Auth-Username":      {"dGVzdGVyMg=="}, // tester2
+		"X-Auth-Userid":        {"2"},
+	}
+
+	emu := startAaaEmu()
+	defer emu.Close()
+	runTest(t, func(e *echo.Echo) {
+		c, b, _ := request("POST", "/chat", strings.NewReader(`{"name": "Chat with read receipts", "participantIds": [2]}`), e)
+		assert.Equal(t, http.StatusCreated, c)
+		chatIdString := interfaceToString(getJsonPathResult(t, b, "$.id").(interface{}))
+
+		c1, b1, _ := request("POST", "/chat/"+chatIdString+"/message", strings.NewReader(`{"text": "Did you read it?"}`), e)
+		assert.Equal(t, http.StatusCreated, c1)
+		messageIdString := interfaceToString(getJsonPathResult(t, b1, "$.id").(interface{}))
+		// the owner isn't counted
+		assert.Equal(t, "0", interfaceToString(getJsonPathRaw(t, b1, "$.readBy")))
+
+		c2, _, _ := requestWithHeader("PUT", "/chat/"+chatIdString+"/message/read/"+messageIdString, h2, nil, e)
+		assert.Equal(t, http.StatusAccepted, c2)
+
+		c3, b3, _ := request("GET", "/chat/"+chatIdString+"/message/"+messageIdString, nil, e)
+		assert.Equal(t, http.StatusOK, c3)
+		assert.Equal(t, "1", interfaceToString(getJsonPathResult(t, b3, "$.readBy").(interface{})))
+
+		c4, b4, _ := request("GET", "/chat/"+chatIdString+"/message/"+messageIdString+"/readers", nil, e)
+		assert.Equal(t, http.StatusOK, c4)
+		assert.Equal(t, "1", interfaceToString(getJsonPathResult(t, b4, "$.totalCount").(interface{})))
+		readerIds := getJsonPathResult(t, b4, "$.data[*].id").([]interface{})
+		assert.Equal(t, 1, len(readerIds))
+		assert.Equal(t, "2", interfaceToString(readerIds[0]))
+
+		c5, _, _ := request("GET", "/chat/"+chatIdString+"/message/100500/readers", nil, e)
+		assert.Equal(t, http.StatusNotFound, c5)
+	})
+}
+
+func TestScheduledMessages(t *testing.T) {
+	emu := startAaaEmu()
+	defer emu.Close()
+	runTest(t, func(e *echo.Echo, mc *handlers.MessageHandler) {
+		c, b, _ := request("POST", "/chat", strings.NewReader(`{"name": "Chat with scheduled messages"}`), e)
+		assert.Equal(t, http.StatusCreated, c)
+		chatIdString := interfaceToString(getJsonPathResult(t, b, "$.id").(interface{}))
+
+		past := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+		c0, _, _ := request("POST", "/chat/"+chatIdString+"/message/scheduled", strings.NewReader(`{"text": "Too late", "sendAt": "`+past+`"}`), e)
+		assert.Equal(t, http.StatusBadRequest, c0)
+
+		farFuture := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+		c1, b1, _ := request("POST", "/chat/"+chatIdString+"/message/scheduled", strings.NewReader(`{"text": "See you in an hour", "sendAt": "`+farFuture+`"}`), e)
+		assert.Equal(t, http.StatusCreated, c1)
+		scheduledIdString := interfaceToString(getJsonPathResult(t, b1, "$.id").(interface{}))
+
+		nearFuture := time.Now().Add(time.Second).UTC().Format(time.RFC3339Nano)
+		c2, _, _ := request("POST", "/chat/"+chatIdString+"/message/scheduled", strings.NewReader(`{"text": "See you in a second", "sendAt": "`+nearFuture+`"}`), e)
+		assert.Equal(t, http.StatusCreated, c2)
+
+		c3, b3, _ := request("GET", "/chat/"+chatIdString+"/message/scheduled", nil, e)
+		assert.Equal(t, http.StatusOK, c3)
+		assert.Equal(t, 2, len(getJsonPathResult(t, b3, "$[*].id").([]interface{})))
+
+		time.Sleep(2 * time.Second)
+		mc.SendDueScheduledMessages(context.Background(), 100)
+
+		c4, b4, _ := request("GET", "/chat/"+chatIdString+"/message/scheduled", nil, e)
+		assert.Equal(t, http.StatusOK, c4)
+		leftIds := getJsonPathResult(t, b4, "$[*].id").([]interface{})
+		assert.Equal(t, 1, len(leftIds))
+		assert.Equal(t, scheduledIdString, interfaceToString(leftIds[0]))
+
+		c5, b5, _ := request("GET", "/chat/"+chatIdString+"/message", nil, e)
+		assert.Equal(t, http.StatusOK, c5)
+		texts := getJsonPathResult(t, b5, "$[*].text").([]interface{})
+		assert.Equal(t, []interface{}{"See you in a second"}, texts)
+
+		c6, _, _ := request("DELETE", "/chat/"+chatIdString+"/message/scheduled/"+scheduledIdString, nil, e)
+		assert.Equal(t, http.StatusAccepted, c6)
+
+		c7, _, _ := request("DELETE", "/chat/"+chatIdString+"/message/scheduled/"+scheduledIdString, nil, e)
+		assert.Equal(t, http.StatusNotFound, c7)
+	})
+}
+
+func TestMessageHistory(t *testing.T) {
+	h2 := map[string][]string{
+		echo.HeaderContentType: {"application/json"},
+		"X-Auth-Expiresin":     {"1590022342295000"},
+		"X-Auth-Username":      {"dGVzdGVyMg=="}, // tester2
+		"X-Auth-Userid":        {"2"},
+	}
+
+	emu := startAaaEmu()
+	defer emu.Close()
+	runTest(t, func(e *echo.Echo) {
+		c, b, _ := request("POST", "/chat", strings.NewReader(`{"name": "Chat with edit history", "participantIds": [2]}`), e)
+		assert.Equal(t, http.StatusCreated, c)
+		chatIdString := interfaceToString(getJsonPathResult(t, b, "$.id").(interface{}))
+
+		c1, b1, _ := request("POST", "/chat/"+chatIdString+"/message", strings.NewReader(`{"text": "first version"}`), e)
+		assert.Equal(t, http.StatusCreated, c1)
+		messageIdString := interfaceToString(getJsonPathResult(t, b1, "$.id").(interface{}))
+
+		c2, _, _ := request("PUT", "/chat/"+chatIdString+"/message", strings.NewReader(`{"id": `+messageIdString+`, "text": "second version"}`), e)
+		assert.Equal(t, http.StatusCreated, c2)
+		c3, _, _ := request("PUT", "/chat/"+chatIdString+"/message", strings.NewReader(`{"id": `+messageIdString+`, "text": "third version"}`), e)
+		assert.Equal(t, http.StatusCreated, c3)
+
+		c4, b4, _ := request("GET", "/chat/"+chatIdString+"/message/"+messageIdString+"/history", nil, e)
+		assert.Equal(t, http.StatusOK, c4)
+		assert.Equal(t, false, getJsonPathRaw(t, b4, "$.deleted"))
+		assert.Equal(t, []interface{}{"first version", "second version"}, getJsonPathResult(t, b4, "$.versions[*].text"))
+
+		// neither the author nor an admin
+		c5, _, _ := requestWithHeader("GET", "/chat/"+chatIdString+"/message/"+messageIdString+"/history", h2, nil, e)
+		assert.Equal(t, http.StatusUnauthorized, c5)
+
+		c6, _, _ := request("DELETE", "/chat/"+chatIdString+"/message/"+messageIdString, nil, e)
+		assert.Equal(t, http.StatusAccepted, c6)
+
+		c7, _, _ := request("GET", "/chat/"+chatIdString+"/message/"+messageIdString, nil, e)
+		assert.Equal(t, http.StatusNotFound, c7)
+
+		c8, b8, _ := request("GET", "/chat/"+chatIdString+"/message/"+messageIdString+"/history", nil, e)
+		assert.Equal(t, http.StatusOK, c8)
+		assert.Equal(t, true, getJsonPathResult(t, b8, "$.deleted"))
+		assert.Equal(t, []interface{}{"first version", "second version", "third version"}, getJsonPathResult(t, b8, "$.versions[*].text"))
+		assert.Equal(t, []interface{}{"edited", "edited", "deleted"}, getJsonPathResult(t, b8, "$.versions[*].action"))
+
+		c9, _, _ := requestWithHeader("GET", "/chat/"+chatIdString+"/message/"+messageIdString+"/history", h2, nil, e)
+		assert.Equal(t, http.StatusNotFound, c9)
+
+		c10, b10, _ := request("GET", "/chat/"+chatIdString+"/message/deleted", nil, e)
+		assert.Equal(t, http.StatusOK, c10)
+		assert.Equal(t, []interface{}{"third version"}, getJsonPathResult(t, b10, "$[*].text"))
+
+		c11, _, _ := requestWithHeader("GET", "/chat/"+chatIdString+"/message/deleted", h2, nil, e)
+		assert.Equal(t, http.StatusUnauthorized, c11)
+	})
+}
+
+func TestForwardMessage(t *testing.T) {
+	h2 := map[string][]string{
+		echo.HeaderContentType: {"application/json"},
+		"X-Auth-Expiresin":     {"1590022342295000"},
+		"X-Auth-Username":      {"dGVzdGVyMg=="}, // tester2
+		"X-Auth-Userid":        {"2"},
+	}
+
+	emu := startAaaEmu()
+	defer emu.Close()
+	runTest(t, func(e *echo.Echo) {
+		c, b, _ := requestWithHeader("POST", "/chat", h2, strings.NewReader(`{"name": "Source chat", "participantIds": [1]}`), e)
+		assert.Equal(t, http.StatusCreated, c)
+		sourceChatIdString := interfaceToString(getJsonPathResult(t, b, "$.id").(interface{}))
+
+		c0, b0, _ := request("POST", "/chat", strings.NewReader(`{"name": "Target chat"}`), e)
+		assert.Equal(t, http.StatusCreated, c0)
+		targetChatIdString := interfaceToString(getJsonPathResult(t, b0, "$.id").(interface{}))
+
+		c1, b1, _ := requestWithHeader("POST", "/chat/"+sourceChatIdString+"/message", h2, strings.NewReader(`{"text": "Worth to share"}`), e)
+		assert.Equal(t, http.StatusCreated, c1)
+		messageIdString := interfaceToString(getJsonPathResult(t, b1, "$.id").(interface{}))
+
+		c2, b2, _ := request("POST", "/chat/"+sourceChatIdString+"/message/"+messageIdString+"/forward", strings.NewReader(`{"chatIds": [`+targetChatIdString+`]}`), e)
+		assert.Equal(t, http.StatusCreated, c2)
+		assert.Equal(t, []interface{}{"Worth to share"}, getJsonPathResult(t, b2, "$[*].text"))
+		forwardedIdString := interfaceToString(getJsonPathResult(t, b2, "$[0].id").(interface{}))
+
+		c3, b3, _ := request("GET", "/chat/"+targetChatIdString+"/message/"+forwardedIdString, nil, e)
+		assert.Equal(t, http.StatusOK, c3)
+		assert.Equal(t, "1", interfaceToString(getJsonPathResult(t, b3, "$.ownerId").(interface{})))
+		assert.Equal(t, sourceChatIdString, interfaceToString(getJsonPathResult(t, b3, "$.forwardedFrom.chatId").(interface{})))
+		assert.Equal(t, messageIdString, interfaceToString(getJsonPathResult(t, b3, "$.forwardedFrom.messageId").(interface{})))
+		assert.Equal(t, "2", interfaceToString(getJsonPathResult(t, b3, "$.forwardedFrom.ownerId").(interface{})))
+
+		// forwarding back keeps the original attribution
+		c4, b4, _ := request("POST", "/chat/"+targetChatIdString+"/message/"+forwardedIdString+"/forward", strings.NewReader(`{"chatIds": [`+sourceChatIdString+`]}`), e)
+		assert.Equal(t, http.StatusCreated, c4)
+		assert.Equal(t, messageIdString, interfaceToString(getJsonPathResult(t, b4, "$[0].forwardedFrom.messageId").(interface{})))
+
+		// tester2 isn't a participant of the target chat
+		c5, _, _ := requestWithHeader("POST", "/chat/"+sourceChatIdString+"/message/"+messageIdString+"/forward", h2, strings.NewReader(`{"chatIds": [`+targetChatIdString+`]}`), e)
+		assert.Equal(t, http.StatusBadRequest, c5)
+
+		c6, _, _ := requestWithHeader("POST", "/chat/"+targetChatIdString+"/message/"+forwardedIdString+"/forward", h2, strings.NewReader(`{"chatIds": [`+sourceChatIdString+`]}`), e)
+		assert.Equal(t, http.StatusUnauthorized, c6)
+
+		c7, _, _ := request("POST", "/chat/"+sourceChatIdString+"/message/100500/forward", strings.NewReader(`{"chatIds": [`+targetChatIdString+`]}`), e)
+		assert.Equal(t, http.StatusNotFound, c7)
+	})
+}
+
+func TestMentions(t *testing.T) {
+	h2 := map[string][]string{
+		echo.HeaderContentType: {"application/json"},
+		"X-Auth-Expiresin":     {"1590022342295000"},
+		"X-Auth-Username":      {"dGVzdGVyMg=="}, // tester2
+		"X-Auth-Userid":        {"2"},
+	}
+
+	emu := startAaaEmu()
+	defer emu.Close()
+	runTest(t, func(e *echo.Echo) {
+		c, b, _ := request("POST", "/chat", strings.NewReader(`{"name": "Chat with mentions", "participantIds": [2]}`), e)
+		assert.Equal(t, http.StatusCreated, c)
+		chatIdString := interfaceToString(getJsonPathResult(t, b, "$.id").(interface{}))
+
+		c1, b1, _ := request("POST", "/chat/"+chatIdString+"/message", strings.NewReader(`{"text": "<p>Hello, @Testor_protobuf2! Write to someone@example.com</p>"}`), e)
+		assert.Equal(t, http.StatusCreated, c1)
+		messageIdString := interfaceToString(getJsonPathResult(t, b1, "$.id").(interface{}))
+
+		c2, b2, _ := requestWithHeader("GET", "/chat/mentions", h2, nil, e)
+		assert.Equal(t, http.StatusOK, c2)
+		assert.Equal(t, []interface{}{"Hello, @Testor_protobuf2! Write to someone@example.com"}, getJsonPathResult(t, b2, "$[*].text"))
+		assert.Equal(t, messageIdString, interfaceToString(getJsonPathResult(t, b2, "$[0].messageId").(interface{})))
+
+		c3, b3, _ := requestWithHeader("GET", "/chat/"+chatIdString, h2, nil, e)
+		assert.Equal(t, http.StatusOK, c3)
+		assert.Equal(t, "1", interfaceToString(getJsonPathResult(t, b3, "$.unreadMentions").(interface{})))
+
+		// the author isn't mentioned
+		c4, b4, _ := request("GET", "/chat/mentions", nil, e)
+		assert.Equal(t, http.StatusOK, c4)
+		assert.Equal(t, 0, len(getJsonPathRaw(t, b4, "$.messageId").([]interface{})))
+
+		// the mention is removed by editing
+		c5, _, _ := request("PUT", "/chat/"+chatIdString+"/message", strings.NewReader(`{"id": `+messageIdString+`, "text": "Hello, everyone"}`), e)
+		assert.Equal(t, http.StatusCreated, c5)
+		c6, b6, _ := requestWithHeader("GET", "/chat/mentions", h2, nil, e)
+		assert.Equal(t, http.StatusOK, c6)
+		assert.Equal(t, 0, len(getJsonPathRaw(t, b6, "$.messageId").([]interface{})))
+
+		// and the reading of the message makes the mention read
+		c7, _, _ := request("PUT", "/chat/"+chatIdString+"/message", strings.NewReader(`{"id": `+messageIdString+`, "text": "Hello, @testor_protobuf2"}`), e)
+		assert.Equal(t, http.StatusCreated, c7)
+		c8, b8, _ := requestWithHeader("GET", "/chat/mentions", h2, nil, e)
+		assert.Equal(t, http.StatusOK, c8)
+		assert.Equal(t, 1, len(getJsonPathResult(t, b8, "$.messageId").([]interface{})))
+		c9, _, _ := requestWithHeader("PUT", "/chat/"+chatIdString+"/message/read/"+messageIdString, h2, nil, e)
+		assert.Equal(t, http.StatusAccepted, c9)
+		c10, b10, _ := requestWithHeader("GET", "/chat/mentions", h2, nil, e)
+		assert.Equal(t, http.StatusOK, c10)
+		assert.Equal(t, 0, len(getJsonPathRaw(t, b10, "$.messageId").([]interface{})))
+	})
+}
+
+// creates the chats with one unread message in each, the given user is the participant of all of them
+func createBenchmarkChats(b *testing.B, dbR db.DB, userId int64, chatsCount int) {
+	_, err := dbR.Exec(fmt.Sprintf(`
+		DO $$
+		DECLARE
+			r RECORD;
+		BEGIN
+			FOR i IN 1..%v LOOP
+				SELECT * FROM CREATE_CHAT('bench_%v_' || i) AS (id BIGINT, last_update_date_time TIMESTAMP) INTO r;
+				INSERT INTO chat_participant(chat_id, user_id, admin) VALUES (r.id, %v, true);
+				EXECUTE format('INSERT INTO message_chat_%%s(text, owner_id) VALUES (''bench'', %v)', r.id);
+			END LOOP;
+		END
+		$$;`, chatsCount, userId, userId, userId))
+	assert.NoError(b, err)
+}
+
+func benchmarkUserHeader(userId int64) http.Header {
+	return map[string][]string{
+		echo.HeaderContentType: {"application/json"},
+		"X-Auth-Expiresin":     {"1590022342295000"},
+		"X-Auth-Username":      {"dGVzdGVy"},
+		"X-Auth-Userid":        {utils.Int64ToString(userId)},
+	}
+}
+
+// benchmarkQueriesCount returns how many queries the request makes
+func benchmarkQueriesCount(makeRequest func()) int64 {
+	before := db.QueriesCount()
+	makeRequest()
+	return db.QueriesCount() - before
+}
+
+// the unread counts are taken with the fixed amount of queries, so the time shouldn't grow with the chats count
+func BenchmarkCheckForNew(b *testing.B) {
+	var queriesCounts = map[int]int64{}
+	for i, chatsCount := range []int{10, 2000} {
+		userId := int64(1000 + i)
+		b.Run(fmt.Sprintf("chats_%v", chatsCount), func(b *testing.B) {
+			runTest(b, func(e *echo.Echo, dbR db.DB) {
+				createBenchmarkChats(b, dbR, userId, chatsCount)
+				h := benchmarkUserHeader(userId)
+				queriesCounts[chatsCount] = benchmarkQueriesCount(func() {
+					c, _, _ := requestWithHeader("PUT", "/chat/message/check-for-new", h, nil, e)
+					assert.Equal(b, http.StatusAccepted, c)
+				})
+				assert.Equal(b, queriesCounts[10], queriesCounts[chatsCount])
+				b.ResetTimer()
+				for n := 0; n < b.N; n++ {
+					c, body, _ := requestWithHeader("PUT", "/chat/message/check-for-new", h, nil, e)
+					assert.Equal(b, http.StatusAccepted, c)
+					assert.Equal(b, interfaceToString(chatsCount), interfaceToString(getJsonPathResult(b, body, "$.allUnreadMessages").(interface{})))
+				}
+			})
+		})
+	}
+}
+
+func BenchmarkGetChats(b *testing.B) {
+	var queriesCounts = map[int]int64{}
+	for i, chatsCount := range []int{10, 2000} {
+		userId := int64(2000 + i)
+		b.Run(fmt.Sprintf("chats_%v", chatsCount), func(b *testing.B) {
+			runTest(b, func(e *echo.Echo, dbR db.DB) {
+				createBenchmarkChats(b, dbR, userId, chatsCount)
+				h := benchmarkUserHeader(userId)
+				queriesCounts[chatsCount] = benchmarkQueriesCount(func() {
+					c, _, _ := requestWithHeader("GET", "/chat?size=20", h, nil, e)
+					assert.Equal(b, http.StatusOK, c)
+				})
+				assert.Equal(b, queriesCounts[10], queriesCounts[chatsCount])
+				b.ResetTimer()
+				for n := 0; n < b.N; n++ {
+					c, body, _ := requestWithHeader("GET", "/chat?size=20", h, nil, e)
+					assert.Equal(b, http.StatusOK, c)
+					assert.Equal(b, "1", interfaceToString(getJsonPathResult(b, body, "$.data[0].unreadMessages").(interface{})))
+				}
+			})
+		})
+	}
+}
+
+func TestChatSettings(t *testing.T) {
+	h2 := map[string][]string{
+		echo.HeaderContentType: {"application/json"},
+		"X-Auth-Expiresin":     {"1590022342295000"},
+		"X-Auth-Username":      {"dGVzdGVyMg=="}, // tester2
+		"X-Auth-Userid":        {"2"},
+	}
+
+	emu := startAaaEmu()
+	defer emu.Close()
+	runTest(t, func(e *echo.Echo) {
+		c, b, _ := request("POST", "/chat", strings.NewReader(`{"name": "Chat with settings", "participantIds": [2]}`), e)
+		assert.Equal(t, http.StatusCreated, c)
+		chatId := getJsonPathResult(t, b, "$.id").(interface{})
+		chatIdString := interfaceToString(chatId)
+
+		c1, _, _ := request("POST", "/chat/"+chatIdString+"/message", strings.NewReader(`{"text": "Unread for the second user"}`), e)
+		assert.Equal(t, http.StatusCreated, c1)
+
+		c2, b2, _ := requestWithHeader("GET", "/chat/"+chatIdString+"/settings", h2, nil, e)
+		assert.Equal(t, http.StatusOK, c2)
+		assert.Equal(t, false, getJsonPathResult(t, b2, "$.muted"))
+		assert.Equal(t, false, getJsonPathResult(t, b2, "$.archived"))
+
+		c3, b3, _ := requestWithHeader("PUT", "/chat/message/check-for-new", h2, nil, e)
+		assert.Equal(t, http.StatusAccepted, c3)
+		unreadBefore := getJsonPathResult(t, b3, "$.allUnreadMessages").(float64)
+
+		c4, _, _ := requestWithHeader("PUT", "/chat/"+chatIdString+"/settings", h2, strings.NewReader(`{"muted": true, "archived": true}`), e)
+		assert.Equal(t, http.StatusOK, c4)
+
+		// the muted chat is left out of the badge
+		c5, b5, _ := requestWithHeader("PUT", "/chat/message/check-for-new", h2, nil, e)
+		assert.Equal(t, http.StatusAccepted, c5)
+		assert.Equal(t, unreadBefore-1, getJsonPathResult(t, b5, "$.allUnreadMessages"))
+
+		c6, b6, _ := requestWithHeader("GET", "/chat?archived=true", h2, nil, e)
+		assert.Equal(t, http.StatusOK, c6)
+		assert.Equal(t, []interface{}{chatId}, getJsonPathResult(t, b6, "$.data[*].id"))
+
+		c7, b7, _ := requestWithHeader("GET", "/chat?archived=false", h2, nil, e)
+		assert.Equal(t, http.StatusOK, c7)
+		assert.NotContains(t, getJsonPathRaw(t, b7, "$.data[*].id"), chatId)
+
+		// the settings are personal
+		c8, b8, _ := request("GET", "/chat/"+chatIdString+"/settings", nil, e)
+		assert.Equal(t, http.StatusOK, c8)
+		assert.Equal(t, false, getJsonPathResult(t, b8, "$.archived"))
+
+		c9, _, _ := request("GET", "/chat?archived=maybe", nil, e)
+		assert.Equal(t, http.StatusBadRequest, c9)
+
+		c10, _, _ := request("GET", "/chat/100500/settings", nil, e)
+		assert.Equal(t, http.StatusUnauthorized, c10)
+	})
+}
+
+func TestPinChat(t *testing.T) {
+	emu := startAaaEmu()
+	defer emu.Close()
+	runTest(t, func(e *echo.Echo) {
+		c0, b0, _ := request("GET", "/chat?size=10", nil, e)
+		assert.Equal(t, http.StatusOK, c0)
+		initialIds := getJsonPathResult(t, b0, "$.data.id").([]interface{})
+		assert.Equal(t, 10, len(initialIds))
+		pinnedChatIdString := interfaceToString(initialIds[9])
+
+		c1, b1, _ := request("PUT", "/chat/"+pinnedChatIdString+"/pin?pin=true", nil, e)
+		assert.Equal(t, http.StatusOK, c1)
+		assert.Equal(t, true, getJsonPathResult(t, b1, "$.pinned"))
+
+		// the pinned chat goes first
+		c2, b2, _ := request("GET", "/chat?size=3", nil, e)
+		assert.Equal(t, http.StatusOK, c2)
+		assert.Equal(t, []interface{}{initialIds[9], initialIds[0], initialIds[1]}, getJsonPathResult(t, b2, "$.data.id"))
+		assert.Equal(t, []interface{}{true, false, false}, getJsonPathResult(t, b2, "$.data.pinned"))
+
+		// and the cursor continues after it
+		cursor := getJsonPathResult(t, b2, "$.nextCursor").(string)
+		c3, b3, _ := request("GET", "/chat?size=3&cursor="+url.QueryEscape(cursor), nil, e)
+		assert.Equal(t, http.StatusOK, c3)
+		assert.Equal(t, initialIds[2:5], getJsonPathResult(t, b3, "$.data.id"))
+
+		c4, b4, _ := request("PUT", "/chat/"+pinnedChatIdString+"/pin?pin=false", nil, e)
+		assert.Equal(t, http.StatusOK, c4)
+		assert.Equal(t, false, getJsonPathResult(t, b4, "$.pinned"))
+
+		c5, b5, _ := request("GET", "/chat?size=10", nil, e)
+		assert.Equal(t, http.StatusOK, c5)
+		assert.Equal(t, initialIds, getJsonPathResult(t, b5, "$.data.id"))
+
+		c6, _, _ := request("PUT", "/chat/100500/pin?pin=true", nil, e)
+		assert.Equal(t, http.StatusUnauthorized, c6)
+	})
+}
+
+func TestChatInvites(t *testing.T) {
+	h2 := map[string][]string{
+		echo.HeaderContentType: {"application/json"},
+		"X-Auth-Expiresin":     {"1590022342295000"},
+		"X-Auth-Username":      {"dGVzdGVyMg=="}, // tester2
+		"X-Auth-Userid":        {"2"},
+	}
+	h3 := map[string][]string{
+		echo.HeaderContentType: {"application/json"},
+		"X-Auth-Expiresin":     {"1590022342295000"},
+		"X-Auth-Username":      {"dGVzdGVyMw=="}, // tester3
+		"X-Auth-Userid":        {"3"},
+	}
+
+	emu := startAaaEmu()
+	defer emu.Close()
+	runTest(t, func(e *echo.Echo) {
+		c, b, _ := request("POST", "/chat", strings.NewReader(`{"name": "Chat by link", "visibility": "link"}`), e)
+		assert.Equal(t, http.StatusCreated, c)
+		assert.Equal(t, "link", getJsonPathResult(t, b, "$.visibility"))
+		chatIdString := interfaceToString(getJsonPathResult(t, b, "$.id").(interface{}))
+
+		c1, b1, _ := request("POST", "/chat/"+chatIdString+"/invite", strings.NewReader(`{"maxUses": 1}`), e)
+		assert.Equal(t, http.StatusCreated, c1)
+		token := getJsonPathResult(t, b1, "$.token").(string)
+
+		c2, _, _ := requestWithHeader("POST", "/chat/"+chatIdString+"/invite", h2, strings.NewReader(`{}`), e)
+		assert.Equal(t, http.StatusUnauthorized, c2)
+
+		c3, b3, _ := requestWithHeader("POST", "/chat/join/"+token, h2, nil, e)
+		assert.Equal(t, http.StatusOK, c3)
+		assert.Equal(t, []interface{}{1.0, 2.0}, getJsonPathResult(t, b3, "$.participantIds"))
+
+		// the repeated join doesn't take the use
+		c4, _, _ := requestWithHeader("POST", "/chat/join/"+token, h2, nil, e)
+		assert.Equal(t, http.StatusOK, c4)
+
+		c5, _, _ := requestWithHeader("POST", "/chat/join/"+token, h3, nil, e)
+		assert.Equal(t, http.StatusNotFound, c5)
+
+		c6, b6, _ := request("GET", "/chat/"+chatIdString+"/invite", nil, e)
+		assert.Equal(t, http.StatusOK, c6)
+		assert.Equal(t, []interface{}{1.0}, getJsonPathResult(t, b6, "$[*].uses"))
+
+		c7, _, _ := request("DELETE", "/chat/"+chatIdString+"/invite/"+token, nil, e)
+		assert.Equal(t, http.StatusAccepted, c7)
+		c8, _, _ := requestWithHeader("POST", "/chat/join/"+token, h3, nil, e)
+		assert.Equal(t, http.StatusNotFound, c8)
+
+		// the link chat isn't in the directory and can't be joined without invite
+		c9, _, _ := requestWithHeader("PUT", "/chat/"+chatIdString+"/join", h3, nil, e)
+		assert.Equal(t, http.StatusNotFound, c9)
+
+		c10, _, _ := request("POST", "/chat/"+chatIdString+"/invite", strings.NewReader(`{"maxUses": 0}`), e)
+		assert.Equal(t, http.StatusBadRequest, c10)
+		c11, _, _ := request("POST", "/chat/"+chatIdString+"/invite", strings.NewReader(`{"expireDateTime": "2000-01-01T00:00:00Z"}`), e)
+		assert.Equal(t, http.StatusBadRequest, c11)
+
+		c12, b12, _ := request("POST", "/chat", strings.NewReader(`{"name": "Private chat without invites"}`), e)
+		assert.Equal(t, http.StatusCreated, c12)
+		assert.Equal(t, "private", getJsonPathResult(t, b12, "$.visibility"))
+		privateChatIdString := interfaceToString(getJsonPathResult(t, b12, "$.id").(interface{}))
+		c13, _, _ := request("POST", "/chat/"+privateChatIdString+"/invite", strings.NewReader(`{}`), e)
+		assert.Equal(t, http.StatusBadRequest, c13)
+	})
+}
+
+func TestPublicChats(t *testing.T) {
+	h2 := map[string][]string{
+		echo.HeaderContentType: {"application/json"},
+		"X-Auth-Expiresin":     {"1590022342295000"},
+		"X-Auth-Username":      {"dGVzdGVyMg=="}, // tester2
+		"X-Auth-Userid":        {"2"},
+	}
+	anonymous := map[string][]string{
+		echo.HeaderContentType: {"application/json"},
+	}
+
+	emu := startAaaEmu()
+	defer emu.Close()
+	runTest(t, func(e *echo.Echo) {
+		c, b, _ := request("POST", "/chat", strings.NewReader(`{"name": "Public directory chat", "visibility": "public"}`), e)
+		assert.Equal(t, http.StatusCreated, c)
+		chatId := getJsonPathResult(t, b, "$.id").(interface{})
+		chatIdString := interfaceToString(chatId)
+
+		c1, b1, _ := requestWithHeader("GET", "/chat/public?searchString=directory", anonymous, nil, e)
+		assert.Equal(t, http.StatusOK, c1)
+		assert.Equal(t, []interface{}{chatId}, getJsonPathResult(t, b1, "$.data.id"))
+		assert.Equal(t, []interface{}{1.0}, getJsonPathResult(t, b1, "$.data.participantsCount"))
+		assert.Equal(t, 1.0, getJsonPathResult(t, b1, "$.totalCount"))
+
+		c2, b2, _ := requestWithHeader("PUT", "/chat/"+chatIdString+"/join", h2, nil, e)
+		assert.Equal(t, http.StatusOK, c2)
+		assert.Equal(t, []interface{}{1.0, 2.0}, getJsonPathResult(t, b2, "$.participantIds"))
+
+		// becoming private hides the chat from the directory
+		c3, _, _ := request("PUT", "/chat", strings.NewReader(`{"id": `+chatIdString+`, "name": "Public directory chat", "visibility": "private"}`), e)
+		assert.Equal(t, http.StatusAccepted, c3)
+		c4, b4, _ := requestWithHeader("GET", "/chat/public?searchString=directory", anonymous, nil, e)
+		assert.Equal(t, http.StatusOK, c4)
+		assert.Equal(t, 0.0, getJsonPathRaw(t, b4, "$.totalCount"))
+
+		c5, _, _ := request("POST", "/chat", strings.NewReader(`{"name": "Wrong visibility", "visibility": "secret"}`), e)
+		assert.Equal(t, http.StatusBadRequest, c5)
+	})
+}
+
+func TestParticipantRoles(t *testing.T) {
+	h2 := map[string][]string{
+		echo.HeaderContentType: {"application/json"},
+		"X-Auth-Expiresin":     {"1590022342295000"},
+		"X-Auth-Username":      {"dGVzdGVyMg=="}, // tester2
+		"X-Auth-Userid":        {"2"},
+	}
+
+	emu := startAaaEmu()
+	defer emu.Close()
+	runTest(t, func(e *echo.Echo) {
+		c, b, _ := request("POST", "/chat", strings.NewReader(`{"name": "Chat with roles", "participantIds": [2]}`), e)
+		assert.Equal(t, http.StatusCreated, c)
+		assert.Equal(t, "owner", getJsonPathResult(t, b, "$.role"))
+		chatIdString := interfaceToString(getJsonPathResult(t, b, "$.id").(interface{}))
+
+		c1, b1, _ := request("PUT", "/chat/"+chatIdString+"/user/2?role=moderator", nil, e)
+		assert.Equal(t, http.StatusAccepted, c1)
+		assert.Equal(t, "moderator", getJsonPathResult(t, b1, "$.role"))
+		assert.Equal(t, false, getJsonPathRaw(t, b1, "$.admin"))
+
+		c2, b2, _ := requestWithHeader("GET", "/chat/"+chatIdString, h2, nil, e)
+		assert.Equal(t, http.StatusOK, c2)
+		assert.Equal(t, "moderator", getJsonPathResult(t, b2, "$.role"))
+		assert.Equal(t, true, getJsonPathResult(t, b2, "$.canBroadcast"))
+		assert.Equal(t, false, getJsonPathRaw(t, b2, "$.canEdit"))
+
+		// moderator can't change the roles
+		c3, _, _ := requestWithHeader("PUT", "/chat/"+chatIdString+"/user/1?role=readonly", h2, nil, e)
+		assert.Equal(t, http.StatusUnauthorized, c3)
+
+		c4, _, _ := request("PUT", "/chat/"+chatIdString+"/user/2?role=superhero", nil, e)
+		assert.Equal(t, http.StatusBadRequest, c4)
+
+		// the owner is only transferred
+		c5, _, _ := request("PUT", "/chat/"+chatIdString+"/user/2?role=owner", nil, e)
+		assert.Equal(t, http.StatusUnauthorized, c5)
+
+		c6, b6, _ := request("PUT", "/chat/"+chatIdString+"/user/2?role=readonly", nil, e)
+		assert.Equal(t, http.StatusAccepted, c6)
+		assert.Equal(t, "readonly", getJsonPathResult(t, b6, "$.role"))
+
+		c7, _, _ := requestWithHeader("POST", "/chat/"+chatIdString+"/message", h2, strings.NewReader(`{"text": "can I write?"}`), e)
+		assert.Equal(t, http.StatusBadRequest, c7)
+
+		// the legacy admin flag still works
+		c8, b8, _ := request("PUT", "/chat/"+chatIdString+"/user/2?admin=true", nil, e)
+		assert.Equal(t, http.StatusAccepted, c8)
+		assert.Equal(t, "admin", getJsonPathResult(t, b8, "$.role"))
+		assert.Equal(t, true, getJsonPathResult(t, b8, "$.admin"))
+
+		c9, _, _ := requestWithHeader("POST", "/chat/"+chatIdString+"/message", h2, strings.NewReader(`{"text": "now I can"}`), e)
+		assert.Equal(t, http.StatusCreated, c9)
+
+		// the admin can edit the chat, but can't remove the owner by omitting them
+		c10, _, _ := requestWithHeader("PUT", "/chat", h2, strings.NewReader(`{"id": `+chatIdString+`, "name": "Chat without owner", "participantIds": [2]}`), e)
+		assert.Equal(t, http.StatusUnauthorized, c10)
+		c11, b11, _ := request("GET", "/chat/"+chatIdString, nil, e)
+		assert.Equal(t, http.StatusOK, c11)
+		assert.Equal(t, "Chat with roles", getJsonPathResult(t, b11, "$.name"))
+	})
+}
+
+func TestChannel(t *testing.T) {
+	h2 := map[string][]string{
+		echo.HeaderContentType: {"application/json"},
+		"X-Auth-Expiresin":     {"1590022342295000"},
+		"X-Auth-Username":      {"dGVzdGVyMg=="}, // tester2
+		"X-Auth-Userid":        {"2"},
+	}
+
+	emu := startAaaEmu()
+	defer emu.Close()
+	runTest(t, func(e *echo.Echo) {
+		c, b, _ := request("POST", "/chat", strings.NewReader(`{"name": "Announcements", "participantIds": [2], "channel": true}`), e)
+		assert.Equal(t, http.StatusCreated, c)
+		assert.Equal(t, true, getJsonPathResult(t, b, "$.channel"))
+		assert.Equal(t, true, getJsonPathResult(t, b, "$.canWriteMessage"))
+		chatId := getJsonPathResult(t, b, "$.id")
+		chatIdString := interfaceToString(chatId)
+
+		c1, b1, _ := requestWithHeader("GET", "/chat/"+chatIdString, h2, nil, e)
+		assert.Equal(t, http.StatusOK, c1)
+		assert.Equal(t, true, getJsonPathResult(t, b1, "$.channel"))
+		assert.Equal(t, false, getJsonPathRaw(t, b1, "$.canWriteMessage"))
+
+		c2, _, _ := requestWithHeader("POST", "/chat/"+chatIdString+"/message", h2, strings.NewReader(`{"text": "can I write?"}`), e)
+		assert.Equal(t, http.StatusBadRequest, c2)
+
+		c3, _, _ := request("POST", "/chat/"+chatIdString+"/message", strings.NewReader(`{"text": "the news"}`), e)
+		assert.Equal(t, http.StatusCreated, c3)
+
+		c4, b4, _ := request("GET", "/chat?size=50&type=channel", nil, e)
+		assert.Equal(t, http.StatusOK, c4)
+		assert.Equal(t, chatId, getJsonPathResult(t, b4, "$.data[0].id"))
+		assert.NotContains(t, getJsonPathResult(t, b4, "$.data.channel"), false)
+
+		c5, b5, _ := request("GET", "/chat?size=50&type=group", nil, e)
+		assert.Equal(t, http.StatusOK, c5)
+		assert.NotContains(t, getJsonPathResult(t, b5, "$.data.id"), chatId)
+		assert.NotContains(t, getJsonPathResult(t, b5, "$.data.channel"), true)
+		assert.NotContains(t, getJsonPathResult(t, b5, "$.data.tetATet"), true)
+
+		c6, _, _ := request("GET", "/chat?type=secret", nil, e)
+		assert.Equal(t, http.StatusBadRequest, c6)
+
+		// the channel becomes the usual chat
+		c7, _, _ := request("PUT", "/chat", strings.NewReader(`{"id": `+chatIdString+`, "name": "Announcements", "channel": false}`), e)
+		assert.Equal(t, http.StatusAccepted, c7)
+
+		c8, _, _ := requestWithHeader("POST", "/chat/"+chatIdString+"/message", h2, strings.NewReader(`{"text": "now I can"}`), e)
+		assert.Equal(t, http.StatusCreated, c8)
+	})
+}
+
+func TestChatOwnership(t *testing.T) {
+	h2 := map[string][]string{
+		echo.HeaderContentType: {"application/json"},
+		"X-Auth-Expiresin":     {"1590022342295000"},
+		"X-Auth-Username":      {"dGVzdGVyMg=="}, // tester2
+		"X-Auth-Userid":        {"2"},
+	}
+	h3 := map[string][]string{
+		echo.HeaderContentType: {"application/json"},
+		"X-Auth-Expiresin":     {"1590022342295000"},
+		"X-Auth-Username":      {"dGVzdGVyMw=="}, // tester3
+		"X-Auth-Userid":        {"3"},
+	}
+
+	emu := startAaaEmu()
+	defer emu.Close()
+	runTest(t, func(e *echo.Echo) {
+		c, b, _ := request("POST", "/chat", strings.NewReader(`{"name": "Chat to inherit", "participantIds": [2, 3]}`), e)
+		assert.Equal(t, http.StatusCreated, c)
+		chatIdString := interfaceToString(getJsonPathResult(t, b, "$.id").(interface{}))
+
+		// the only admin can't lose the rights
+		c1, _, _ := request("PUT", "/chat/"+chatIdString+"/user/1?role=member", nil, e)
+		assert.Equal(t, http.StatusBadRequest, c1)
+
+		c2, _, _ := requestWithHeader("PUT", "/chat/"+chatIdString+"/owner/2", h2, nil, e)
+		assert.Equal(t, http.StatusUnauthorized, c2)
+
+		c3, _, _ := request("PUT", "/chat/"+chatIdString+"/owner/1", nil, e)
+		assert.Equal(t, http.StatusBadRequest, c3)
+
+		c4, _, _ := request("PUT", "/chat/"+chatIdString+"/owner/100500", nil, e)
+		assert.Equal(t, http.StatusBadRequest, c4)
+
+		c5, b5, _ := request("PUT", "/chat/"+chatIdString+"/owner/2", nil, e)
+		assert.Equal(t, http.StatusAccepted, c5)
+		assert.Equal(t, "admin", getJsonPathResult(t, b5, "$.role"))
+
+		c6, b6, _ := requestWithHeader("GET", "/chat/"+chatIdString, h2, nil, e)
+		assert.Equal(t, http.StatusOK, c6)
+		assert.Equal(t, "owner", getJsonPathResult(t, b6, "$.role"))
+
+		// the former owner stays the admin, so the owner leaving passes the ownership to them
+		c7, _, _ := requestWithHeader("PUT", "/chat/"+chatIdString+"/leave", h2, nil, e)
+		assert.Equal(t, http.StatusAccepted, c7)
+
+		c8, b8, _ := request("GET", "/chat/"+chatIdString, nil, e)
+		assert.Equal(t, http.StatusOK, c8)
+		assert.Equal(t, "owner", getJsonPathResult(t, b8, "$.role"))
+
+		// the last admin leaves, so the longest-tenured participant becomes the owner
+		c9, _, _ := request("PUT", "/chat/"+chatIdString+"/leave", nil, e)
+		assert.Equal(t, http.StatusAccepted, c9)
+
+		c10, b10, _ := requestWithHeader("GET", "/chat/"+chatIdString, h3, nil, e)
+		assert.Equal(t, http.StatusOK, c10)
+		assert.Equal(t, "owner", getJsonPathResult(t, b10, "$.role"))
+	})
+}
+
+func TestDeleteAndRestoreChat(t *testing.T) {
+	h2 := map[string][]string{
+		echo.HeaderContentType: {"application/json"},
+		"X-Auth-Expiresin":     {"1590022342295000"},
+		"X-Auth-Username":      {"dGVzdGVyMg=="}, // tester2
+		"X-Auth-Userid":        {"2"},
+	}
+
+	h3 := map[string][]string{
+		echo.HeaderContentType: {"application/json"},
+		"X-Auth-Expiresin":     {"1590022342295000"},
+		"X-Auth-Username":      {"dGVzdGVyMw=="}, // tester3
+		"X-Auth-Userid":        {"3"},
+	}
+
+	emu := startAaaEmu()
+	defer emu.Close()
+	runTest(t, func(e *echo.Echo, dbR db.DB, ch *handlers.ChatHandler) {
+		c, b, _ := request("POST", "/chat", strings.NewReader(`{"name": "Chat to restore", "participantIds": [2], "visibility": "link"}`), e)
+		assert.Equal(t, http.StatusCreated, c)
+		chatIdString := interfaceToString(getJsonPathResult(t, b, "$.id").(interface{}))
+		chatId, _ := utils.ParseInt64(chatIdString)
+
+		c01, b01, _ := request("POST", "/chat/"+chatIdString+"/invite", strings.NewReader(`{}`), e)
+		assert.Equal(t, http.StatusCreated, c01)
+		token := getJsonPathResult(t, b01, "$.token").(string)
+
+		c02, _, _ := request("POST", "/chat/"+chatIdString+"/message", strings.NewReader(`{"text": "Hippopotamuses are restorable"}`), e)
+		assert.Equal(t, http.StatusCreated, c02)
+
+		c1, _, _ := request("DELETE", "/chat/"+chatIdString, nil, e)
+		assert.Equal(t, http.StatusAccepted, c1)
+
+		// the invite doesn't add to the deleted chat
+		c12, _, _ := requestWithHeader("POST", "/chat/join/"+token, h3, nil, e)
+		assert.Equal(t, http.StatusNotFound, c12)
+
+		// the messages of the deleted chat aren't found until the restore
+		c13, b13, _ := request("GET", "/chat/message/search?searchString=hippopotamus", nil, e)
+		assert.Equal(t, http.StatusOK, c13)
+		assert.Equal(t, 0, len(getJsonPathRaw(t, b13, "$.id").([]interface{})))
+
+		c2, _, _ := request("GET", "/chat/"+chatIdString, nil, e)
+		assert.Equal(t, http.StatusNotFound, c2)
+
+		c3, _, _ := request("POST", "/chat/"+chatIdString+"/message", strings.NewReader(`{"text": "anybody here?"}`), e)
+		assert.Equal(t, http.StatusBadRequest, c3)
+
+		// the storage keeps the files until the purge
+		c4, b4, _ := request("GET", "/internal/is-chat-exists/"+chatIdString, nil, e)
+		assert.Equal(t, http.StatusOK, c4)
+		assert.Equal(t, true, getJsonPathResult(t, b4, "$.exists"))
+
+		c5, _, _ := requestWithHeader("POST", "/chat/"+chatIdString+"/restore", h2, nil, e)
+		assert.Equal(t, http.StatusUnauthorized, c5)
+
+		c6, b6, _ := request("POST", "/chat/"+chatIdString+"/restore", nil, e)
+		assert.Equal(t, http.StatusOK, c6)
+		assert.Equal(t, "Chat to restore", getJsonPathResult(t, b6, "$.name"))
+
+		c7, _, _ := requestWithHeader("GET", "/chat/"+chatIdString, h2, nil, e)
+		assert.Equal(t, http.StatusOK, c7)
+
+		c14, b14, _ := request("GET", "/chat/message/search?searchString=hippopotamus", nil, e)
+		assert.Equal(t, http.StatusOK, c14)
+		assert.Equal(t, 1, len(getJsonPathRaw(t, b14, "$.id").([]interface{})))
+
+		c8, _, _ := request("POST", "/chat/"+chatIdString+"/restore", nil, e)
+		assert.Equal(t, http.StatusNotFound, c8)
+
+		// the retention period is over
+		c9, _, _ := request("DELETE", "/chat/"+chatIdString, nil, e)
+		assert.Equal(t, http.StatusAccepted, c9)
+		_, err := dbR.Exec("UPDATE chat SET delete_date_time = delete_date_time - interval '1 year' WHERE id = $1", chatId)
+		assert.Nil(t, err)
+
+		c10, _, _ := request("POST", "/chat/"+chatIdString+"/restore", nil, e)
+		assert.Equal(t, http.StatusNotFound, c10)
+
+		ch.PurgeDeletedChats(100)
+
+		c11, b11, _ := request("GET", "/internal/is-chat-exists/"+chatIdString, nil, e)
+		assert.Equal(t, http.StatusOK, c11)
+		assert.Equal(t, false, getJsonPathRaw(t, b11, "$.exists"))
+	})
+}
+
+func TestChatWebhooks(t *testing.T) {
+	h2 := map[string][]string{
+		echo.HeaderContentType: {"application/json"},
+		"X-Auth-Expiresin":     {"1590022342295000"},
+		"X-Auth-Username":      {"dGVzdGVyMg=="}, // tester2
+		"X-Auth-Userid":        {"2"},
+	}
+	const secret = "0123456789abcdef"
+
+	var hits int32
+	var receivedSignature, receivedEvent string
+	var receivedBody []byte
+	receiver := test.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the first attempt fails
+		if atomic.AddInt32(&hits, 1) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		receivedSignature = r.Header.Get(services.WebhookSignatureHeader)
+		receivedEvent = r.Header.Get(services.WebhookEventHeader)
+		receivedBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer receiver.Close()
+
+	emu := startAaaEmu()
+	defer emu.Close()
+	runTest(t, func(e *echo.Echo, dbR db.DB) {
+		c, b, _ := request("POST", "/chat", strings.NewReader(`{"name": "Chat with webhooks", "participantIds": [2]}`), e)
+		assert.Equal(t, http.StatusCreated, c)
+		chatIdString := interfaceToString(getJsonPathResult(t, b, "$.id").(interface{}))
+		chatId, _ := utils.ParseInt64(chatIdString)
+
+		c1, _, _ := requestWithHeader("POST", "/chat/"+chatIdString+"/webhooks", h2, strings.NewReader(`{"url": "`+receiver.URL+`"}`), e)
+		assert.Equal(t, http.StatusUnauthorized, c1)
+
+		c2, _, _ := request("POST", "/chat/"+chatIdString+"/webhooks", strings.NewReader(`{"url": "ftp://example.com"}`), e)
+		assert.Equal(t, http.StatusBadRequest, c2)
+
+		for _, internalUrl := range []string{receiver.URL, "http://localhost:8080", "http://169.254.169.254/latest/meta-data"} {
+			c, _, _ := request("POST", "/chat/"+chatIdString+"/webhooks", strings.NewReader(`{"url": "`+internalUrl+`"}`), e)
+			assert.Equal(t, http.StatusBadRequest, c, internalUrl)
+		}
+		// the receiver is local
+		viper.Set("webhooks.allowPrivateAddresses", true)
+		defer viper.Set("webhooks.allowPrivateAddresses", false)
+
+		c3, b3, _ := request("POST", "/chat/"+chatIdString+"/webhooks", strings.NewReader(`{"url": "`+receiver.URL+`", "secret": "`+secret+`", "eventTypes": ["message_created"]}`), e)
+		assert.Equal(t, http.StatusCreated, c3)
+		assert.Equal(t, secret, getJsonPathResult(t, b3, "$.secret"))
+		hookIdString := interfaceToString(getJsonPathResult(t, b3, "$.id").(interface{}))
+
+		// the secret isn't shown anymore
+		c4, b4, _ := request("GET", "/chat/"+chatIdString+"/webhooks", nil, e)
+		assert.Equal(t, http.StatusOK, c4)
+		assert.Equal(t, []interface{}{"message_created"}, getJsonPathResult(t, b4, "$[0].eventTypes"))
+		assert.NotContains(t, b4, secret)
+
+		viper.Set("webhooks.initialBackoff", "10ms")
+		dispatcher := services.NewWebhookDispatcher(dbR)
+		dispatcher.Dispatch(chatId, "user_typing", []byte(`{"eventType": "user_typing"}`))
+		payload := []byte(`{"eventType": "message_created", "chatId": ` + chatIdString + `}`)
+		dispatcher.Dispatch(chatId, "message_created", payload)
+
+		var b5 string
+		assert.Eventually(t, func() bool {
+			var c5 int
+			c5, b5, _ = request("GET", "/chat/"+chatIdString+"/webhooks/"+hookIdString+"/deliveries", nil, e)
+			return c5 == http.StatusOK && strings.Contains(b5, `"success":true`)
+		}, 5*time.Second, 50*time.Millisecond)
+		assert.Equal(t, []interface{}{true, false}, getJsonPathResult(t, b5, "$[*].success"))
+		assert.Equal(t, []interface{}{200.0, 500.0}, getJsonPathResult(t, b5, "$[*].statusCode"))
+		assert.Equal(t, []interface{}{2.0, 1.0}, getJsonPathResult(t, b5, "$[*].attempt"))
+
+		assert.Equal(t, int32(2), atomic.LoadInt32(&hits))
+		assert.Equal(t, "message_created", receivedEvent)
+		assert.Equal(t, payload, receivedBody)
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(payload)
+		assert.Equal(t, "sha256="+hex.EncodeToString(mac.Sum(nil)), receivedSignature)
+
+		c6, _, _ := request("DELETE", "/chat/"+chatIdString+"/webhooks/"+hookIdString, nil, e)
+		assert.Equal(t, http.StatusAccepted, c6)
+
+		c7, _, _ := request("GET", "/chat/"+chatIdString+"/webhooks/"+hookIdString+"/deliveries", nil, e)
+		assert.Equal(t, http.StatusNotFound, c7)
+	})
+}
+
+// the unreachable webhook of one chat doesn't delay the events of the others
+func TestChatWebhooksBlackHole(t *testing.T) {
+	blackHoleReleased := make(chan struct{})
+	blackHole := test.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-blackHoleReleased
+	}))
+	defer blackHole.Close()
+	defer close(blackHoleReleased)
+
+	received := make(chan string, 1)
+	receiver := test.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received <- r.Header.Get(services.WebhookEventHeader)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer receiver.Close()
+
+	viper.Set("webhooks.allowPrivateAddresses", true)
+	defer viper.Set("webhooks.allowPrivateAddresses", false)
+	emu := startAaaEmu()
+	defer emu.Close()
+	runTest(t, func(e *echo.Echo, dbR db.DB) {
+		var chatIds []int64
+		for _, hookUrl := range []string{blackHole.URL, receiver.URL} {
+			c, b, _ := request("POST", "/chat", strings.NewReader(`{"name": "Chat with webhook"}`), e)
+			assert.Equal(t, http.StatusCreated, c)
+			chatIdString := interfaceToString(getJsonPathResult(t, b, "$.id").(interface{}))
+			chatId, _ := utils.ParseInt64(chatIdString)
+			chatIds = append(chatIds, chatId)
+
+			c1, _, _ := request("POST", "/chat/"+chatIdString+"/webhooks", strings.NewReader(`{"url": "`+hookUrl+`", "eventTypes": ["message_created"]}`), e)
+			assert.Equal(t, http.StatusCreated, c1)
+		}
+
+		dispatcher := services.NewWebhookDispatcher(dbR)
+		start := time.Now()
+		for _, chatId := range chatIds {
+			dispatcher.Dispatch(chatId, "message_created", []byte(`{"eventType": "message_created"}`))
+		}
+		select {
+		case eventType := <-received:
+			assert.Equal(t, "message_created", eventType)
+			assert.Less(t, time.Since(start), viper.GetDuration("webhooks.timeout"))
+		case <-time.After(5 * time.Second):
+			assert.Fail(t, "the event is stuck behind the unreachable webhook")
+		}
+	})
+}
+
+func TestIncomingWebhook(t *testing.T) {
+	h2 := map[string][]string{
+		echo.HeaderContentType: {"application/json"},
+		"X-Auth-Expiresin":     {"1590022342295000"},
+		"X-Auth-Username":      {"dGVzdGVyMg=="}, // tester2
+		"X-Auth-Userid":        {"2"},
+	}
+	plain := map[string][]string{
+		echo.HeaderContentType: {"text/plain"},
+	}
+	slack := map[string][]string{
+		echo.HeaderContentType: {"application/json"},
+	}
+
+	viper.Set("incomingWebhooks.rateLimit.requests", 3)
+	emu := startAaaEmu()
+	defer emu.Close()
+	runTest(t, func(e *echo.Echo) {
+		c, b, _ := request("POST", "/chat", strings.NewReader(`{"name": "Chat with incoming webhook", "participantIds": [2]}`), e)
+		assert.Equal(t, http.StatusCreated, c)
+		chatIdString := interfaceToString(getJsonPathResult(t, b, "$.id").(interface{}))
+
+		c1, _, _ := requestWithHeader("POST", "/chat/"+chatIdString+"/incoming-webhooks", h2, strings.NewReader(`{"name": "CI"}`), e)
+		assert.Equal(t, http.StatusUnauthorized, c1)
+
+		c2, b2, _ := request("POST", "/chat/"+chatIdString+"/incoming-webhooks", strings.NewReader(`{"name": "CI"}`), e)
+		assert.Equal(t, http.StatusCreated, c2)
+		token := getJsonPathResult(t, b2, "$.token").(string)
+		botId := getJsonPathResult(t, b2, "$.botId").(float64)
+
+		c3, _, _ := requestWithHeader("POST", "/chat/hooks/"+token, plain, strings.NewReader("Build <b>passed</b>\nsee the log<script>alert(1)</script>"), e)
+		assert.Equal(t, http.StatusOK, c3)
+
+		c4, b4, _ := request("GET", "/chat/"+chatIdString+"/message", nil, e)
+		assert.Equal(t, http.StatusOK, c4)
+		assert.Equal(t, "Build <b>passed</b><br>see the log", getJsonPathResult(t, b4, "$[0].text"))
+		assert.Equal(t, -botId, getJsonPathResult(t, b4, "$[0].ownerId"))
+		assert.Equal(t, "CI", getJsonPathResult(t, b4, "$[0].owner.login"))
+
+		c5, _, _ := requestWithHeader("POST", "/chat/hooks/"+token, slack, strings.NewReader(`{"text": "Deployed &lt;v2&gt;"}`), e)
+		assert.Equal(t, http.StatusOK, c5)
+		_, b6, _ := request("GET", "/chat/"+chatIdString+"/message", nil, e)
+		assert.Equal(t, "Deployed &lt;v2&gt;", getJsonPathResult(t, b6, "$[1].text"))
+
+		c61, _, _ := requestWithHeader("POST", "/chat/hooks/"+token, slack, strings.NewReader(`{"text": "`+strings.Repeat("a", 1024*1024+1)+`"}`), e)
+		assert.Equal(t, http.StatusBadRequest, c61)
+
+		// the burst is over
+		c7, _, h7 := requestWithHeader("POST", "/chat/hooks/"+token, slack, strings.NewReader(`{"text": "Too often"}`), e)
+		assert.Equal(t, http.StatusTooManyRequests, c7)
+		assert.NotEmpty(t, h7.Get("Retry-After"))
+
+		c8, _, _ := requestWithHeader("POST", "/chat/hooks/unknown", plain, strings.NewReader("Hello"), e)
+		assert.Equal(t, http.StatusNotFound, c8)
+
+		c9, b9, _ := request("GET", "/chat/"+chatIdString+"/incoming-webhooks", nil, e)
+		assert.Equal(t, http.StatusOK, c9)
+		assert.Equal(t, "CI", getJsonPathResult(t, b9, "$[0].botName"))
+
+		c10, _, _ := request("DELETE", "/chat/"+chatIdString+"/incoming-webhooks/"+token, nil, e)
+		assert.Equal(t, http.StatusAccepted, c10)
+
+		c11, _, _ := requestWithHeader("POST", "/chat/hooks/"+token, plain, strings.NewReader("Hello"), e)
+		assert.Equal(t, http.StatusNotFound, c11)
+	})
+}
+
+func TestBots(t *testing.T) {
+	botHeader := func(token string) http.Header {
+		return map[string][]string{
+			echo.HeaderContentType:   {"application/json"},
+			echo.HeaderAuthorization: {"Bot " + token},
+		}
+	}
+
+	viper.Set("bots.longPoll.interval", "50ms")
+	emu := startAaaEmu()
+	defer emu.Close()
+	runTest(t, func(e *echo.Echo, dbR db.DB) {
+		c, b, _ := request("POST", "/chat", strings.NewReader(`{"name": "Chat with bot"}`), e)
+		assert.Equal(t, http.StatusCreated, c)
+		chatIdString := interfaceToString(getJsonPathResult(t, b, "$.id").(interface{}))
+
+		c1, b1, _ := request("POST", "/chat/bot", strings.NewReader(`{"name": "Standup"}`), e)
+		assert.Equal(t, http.StatusCreated, c1)
+		token := getJsonPathResult(t, b1, "$.token").(string)
+		botIdString := interfaceToString(getJsonPathResult(t, b1, "$.id").(interface{}))
+		botUserIdString := interfaceToString(getJsonPathResult(t, b1, "$.userId").(interface{}))
+		assert.Equal(t, "-"+botIdString, botUserIdString)
+
+		c2, b2, _ := request("GET", "/chat/bot", nil, e)
+		assert.Equal(t, http.StatusOK, c2)
+		assert.Equal(t, "Standup", getJsonPathResult(t, b2, "$[0].name"))
+		assert.NotContains(t, b2, token)
+
+		c3, _, _ := requestWithHeader("GET", "/chat/"+chatIdString+"/message", botHeader("wrong"), nil, e)
+		assert.Equal(t, http.StatusUnauthorized, c3)
+
+		c4, _, _ := request("PUT", "/chat/"+chatIdString+"/users", strings.NewReader(`{"participantIds": [-999999]}`), e)
+		assert.Equal(t, http.StatusBadRequest, c4)
+		c41, _, _ := request("POST", "/chat", strings.NewReader(`{"name": "Chat with unknown bot", "participantIds": [-999999]}`), e)
+		assert.Equal(t, http.StatusBadRequest, c41)
+		c42, _, _ := request("PUT", "/chat", strings.NewReader(`{"id": `+chatIdString+`, "name": "Chat with unknown bot", "participantIds": [1, -999999]}`), e)
+		assert.Equal(t, http.StatusBadRequest, c42)
+		c5, _, _ := request("PUT", "/chat/"+chatIdString+"/users", strings.NewReader(`{"participantIds": [`+botUserIdString+`]}`), e)
+		assert.Equal(t, http.StatusAccepted, c5)
+
+		// the bot can't go beyond the allowed endpoints
+		c6, _, _ := requestWithHeader("POST", "/chat", botHeader(token), strings.NewReader(`{"name": "Bot's chat"}`), e)
+		assert.Equal(t, http.StatusUnauthorized, c6)
+
+		c7, b7, _ := requestWithHeader("POST", "/chat/"+chatIdString+"/message", botHeader(token), strings.NewReader(`{"text": "Standup time"}`), e)
+		assert.Equal(t, http.StatusCreated, c7)
+		messageIdString := interfaceToString(getJsonPathResult(t, b7, "$.id").(interface{}))
+
+		c8, _, _ := requestWithHeader("PUT", "/chat/"+chatIdString+"/message", botHeader(token), strings.NewReader(`{"id": `+messageIdString+`, "text": "Standup time, join the call"}`), e)
+		assert.Equal(t, http.StatusCreated, c8)
+
+		c9, _, _ := requestWithHeader("PUT", "/chat/"+chatIdString+"/message/"+messageIdString+"/reaction", botHeader(token), strings.NewReader(`{"reaction": "👍"}`), e)
+		assert.Equal(t, http.StatusOK, c9)
+
+		c10, b10, _ := request("GET", "/chat/"+chatIdString+"/message", nil, e)
+		assert.Equal(t, http.StatusOK, c10)
+		assert.Equal(t, "Standup time, join the call", getJsonPathResult(t, b10, "$[0].text"))
+		assert.Equal(t, true, getJsonPathResult(t, b10, "$[0].bot"))
+		assert.Equal(t, "Standup", getJsonPathResult(t, b10, "$[0].owner.login"))
+		assert.Equal(t, "👍", getJsonPathResult(t, b10, "$[0].reactions[0].reaction"))
+
+		// the rabbitmq listener isn't started in the test
+		onEvent := listener.CreateBotsListener(dbR)
+		botUserId, _ := utils.ParseInt64(botUserIdString)
+		event, _ := json.Marshal(dto.ChatEvent{EventType: "message_created", ChatId: 1, UserId: botUserId})
+		assert.Nil(t, onEvent(&amqp.Delivery{Type: utils.GetType(dto.ChatEvent{}), Body: event}))
+		userEvent, _ := json.Marshal(dto.ChatEvent{EventType: "message_created", ChatId: 1, UserId: 1})
+		assert.Nil(t, onEvent(&amqp.Delivery{Type: utils.GetType(dto.ChatEvent{}), Body: userEvent}))
+
+		c11, b11, _ := requestWithHeader("GET", "/chat/bot/events?timeout=0", botHeader(token), nil, e)
+		assert.Equal(t, http.StatusOK, c11)
+		assert.Equal(t, []interface{}{"message_created"}, getJsonPathResult(t, b11, "$[*].eventType"))
+		assert.Equal(t, botUserId, int64(getJsonPathResult(t, b11, "$[0].payload.userId").(float64)))
+		eventIdString := interfaceToString(getJsonPathResult(t, b11, "$[0].id").(interface{}))
+
+		startedAt := time.Now()
+		c12, b12, _ := requestWithHeader("GET", "/chat/bot/events?timeout=1&after="+eventIdString, botHeader(token), nil, e)
+		assert.Equal(t, http.StatusOK, c12)
+		assert.Equal(t, "[]", strings.TrimSpace(b12))
+		assert.GreaterOrEqual(t, time.Since(startedAt), time.Second)
+
+		c13, _, _ := request("GET", "/chat/bot/events", nil, e)
+		assert.Equal(t, http.StatusUnauthorized, c13)
+
+		c14, b14, _ := request("PUT", "/chat/bot/"+botIdString+"/token", nil, e)
+		assert.Equal(t, http.StatusOK, c14)
+		newToken := getJsonPathResult(t, b14, "$.token").(string)
+		c15, _, _ := requestWithHeader("GET", "/chat/bot/events?timeout=0", botHeader(token), nil, e)
+		assert.Equal(t, http.StatusUnauthorized, c15)
+		c16, _, _ := requestWithHeader("GET", "/chat/bot/events?timeout=0", botHeader(newToken), nil, e)
+		assert.Equal(t, http.StatusOK, c16)
+	})
+}
+
+func TestSlashCommands(t *testing.T) {
+	emu := startAaaEmu()
+	defer emu.Close()
+	runTest(t, func(e *echo.Echo) {
+		c, b, _ := request("POST", "/chat", strings.NewReader(`{"name": "Chat with commands"}`), e)
+		assert.Equal(t, http.StatusCreated, c)
+		chatIdString := interfaceToString(getJsonPathResult(t, b, "$.id").(interface{}))
+
+		c1, b1, _ := request("GET", "/chat/"+chatIdString+"/commands?searchString=/to", nil, e)
+		assert.Equal(t, http.StatusOK, c1)
+		assert.Equal(t, []interface{}{"topic"}, getJsonPathResult(t, b1, "$[*].name"))
+
+		c2, b2, _ := request("POST", "/chat/"+chatIdString+"/message", strings.NewReader(`{"text": "<p>/topic Renamed by command</p>"}`), e)
+		assert.Equal(t, http.StatusOK, c2)
+		assert.Equal(t, "topic", getJsonPathResult(t, b2, "$.command"))
+		c3, b3, _ := request("GET", "/chat/"+chatIdString, nil, e)
+		assert.Equal(t, http.StatusOK, c3)
+		assert.Equal(t, "Renamed by command", getJsonPathResult(t, b3, "$.name"))
+
+		c4, _, _ := request("POST", "/chat/"+chatIdString+"/message", strings.NewReader(`{"text": "/invite @Testor_protobuf2"}`), e)
+		assert.Equal(t, http.StatusOK, c4)
+		c5, b5, _ := request("GET", "/chat/"+chatIdString, nil, e)
+		assert.Equal(t, http.StatusOK, c5)
+		assert.Equal(t, 2, len(getJsonPathResult(t, b5, "$.participantIds").([]interface{})))
+
+		// the participant can't be found once more
+		c6, b6, _ := request("POST", "/chat/"+chatIdString+"/message", strings.NewReader(`{"text": "/invite @testor_protobuf2"}`), e)
+		assert.Equal(t, http.StatusBadRequest, c6)
+		assert.Equal(t, "User testor_protobuf2 is not found or is already in the chat", getJsonPathResult(t, b6, "$.message"))
+
+		c7, _, _ := request("POST", "/chat/"+chatIdString+"/message", strings.NewReader(`{"text": "/remind soon drink water"}`), e)
+		assert.Equal(t, http.StatusBadRequest, c7)
+		c8, _, _ := request("POST", "/chat/"+chatIdString+"/message", strings.NewReader(`{"text": "/remind 10m drink water"}`), e)
+		assert.Equal(t, http.StatusOK, c8)
+		c9, b9, _ := request("GET", "/chat/"+chatIdString+"/message/scheduled", nil, e)
+		assert.Equal(t, http.StatusOK, c9)
+		assert.Equal(t, []interface{}{"drink water"}, getJsonPathResult(t, b9, "$[*].text"))
+
+		// the unknown command is the regular message, and the commands aren't stored
+		c10, _, _ := request("POST", "/chat/"+chatIdString+"/message", strings.NewReader(`{"text": "/shrug"}`), e)
+		assert.Equal(t, http.StatusCreated, c10)
+		c11, b11, _ := request("GET", "/chat/"+chatIdString+"/message", nil, e)
+		assert.Equal(t, http.StatusOK, c11)
+		assert.Equal(t, []interface{}{"/shrug"}, getJsonPathResult(t, b11, "$[*].text"))
+	})
+}
+
+func TestLinkPreviews(t *testing.T) {
+	page := test.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, `<html><head><meta property="og:title" content="Elephants"><meta property="og:description" content="Never forget"></head></html>`)
+	}))
+	defer page.Close()
+	viper.Set("linkPreviews.allowedHosts", []string{"^127\\.0\\.0\\.1$"})
+	viper.Set("linkPreviews.allowPrivateAddresses", true)
+
+	emu := startAaaEmu()
+	defer emu.Close()
+	runTest(t, func(e *echo.Echo) {
+		c, b, _ := request("POST", "/chat", strings.NewReader(`{"name": "Chat with links"}`), e)
+		assert.Equal(t, http.StatusCreated, c)
+		chatIdString := interfaceToString(getJsonPathResult(t, b, "$.id").(interface{}))
+
+		c1, b1, _ := request("POST", "/chat/"+chatIdString+"/message", strings.NewReader(`{"text": "<p>Read `+page.URL+`/elephants</p>"}`), e)
+		assert.Equal(t, http.StatusCreated, c1)
+		messageIdString := interfaceToString(getJsonPathResult(t, b1, "$.id").(interface{}))
+		// is fetched after the message is saved
+		assert.Equal(t, 0, len(getJsonPathRaw(t, b1, "$.linkPreviews").([]interface{})))
+
+		var previewBody string
+		for i := 0; i < 50; i++ {
+			_, previewBody, _ = request("GET", "/chat/"+chatIdString+"/message/"+messageIdString, nil, e)
+			if len(getJsonPathRaw(t, previewBody, "$.linkPreviews").([]interface{})) > 0 {
+				break
+			}
+			time.Sleep(100 * time.Millisecond)
+		}
+		assert.Equal(t, page.URL+"/elephants", getJsonPathResult(t, previewBody, "$.linkPreviews[0].url"))
+		assert.Equal(t, "Elephants", getJsonPathResult(t, previewBody, "$.linkPreviews[0].title"))
+		assert.Equal(t, "Never forget", getJsonPathResult(t, previewBody, "$.linkPreviews[0].description"))
+		assert.Equal(t, "127.0.0.1", getJsonPathResult(t, previewBody, "$.linkPreviews[0].siteName"))
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"github.com/beliyav/go-amqp-reconnect/rabbitmq"
 	"github.com/streadway/amqp"
+	"nkonev.name/chat/dto"
 	. "nkonev.name/chat/logger"
 	myRabbitmq "nkonev.name/chat/rabbitmq"
 	"nkonev.name/chat/utils"
@@ -12,7 +13,19 @@ import (
 
 const AsyncEventsFanoutExchange = "async-events-exchange"
 
+// WebhooksQueue is durable and shared among the instances of chat, so every event is dispatched to the webhooks once
+const WebhooksQueue = "chat-webhooks"
+
 func (rp *RabbitFanoutNotificationsPublisher) Publish(aDto interface{}) error {
+	return rp.publish(AsyncEventsFanoutExchange, "", aDto)
+}
+
+// PublishToWebhooks sends the event through the default exchange right to WebhooksQueue, the exchange's consumers don't need it
+func (rp *RabbitFanoutNotificationsPublisher) PublishToWebhooks(event dto.WebhookEvent) error {
+	return rp.publish("", WebhooksQueue, event)
+}
+
+func (rp *RabbitFanoutNotificationsPublisher) publish(exchange, routingKey string, aDto interface{}) error {
 	aType := utils.GetType(aDto)
 
 	bytea, err := json.Marshal(aDto)
@@ -29,7 +42,7 @@ func (rp *RabbitFanoutNotificationsPublisher) Publish(aDto interface{}) error {
 		Type:         aType,
 	}
 
-	if err := rp.channel.Publish(AsyncEventsFanoutExchange, "", false, false, msg); err != nil {
+	if err := rp.channel.Publish(exchange, routingKey, false, false, msg); err != nil {
 		Logger.Error(err, "Error during publishing dto")
 		return err
 	} else {
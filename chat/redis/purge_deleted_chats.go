@@ -0,0 +1,44 @@
+package redis
+
+import (
+	"github.com/ehsaniara/gointerlock"
+	redisV8 "github.com/go-redis/redis/v8"
+	"github.com/spf13/viper"
+	"nkonev.name/chat/handlers"
+	. "nkonev.name/chat/logger"
+)
+
+type PurgeDeletedChatsService struct {
+	chatHandler *handlers.ChatHandler
+}
+
+func NewPurgeDeletedChatsService(chatHandler *handlers.ChatHandler) *PurgeDeletedChatsService {
+	return &PurgeDeletedChatsService{
+		chatHandler: chatHandler,
+	}
+}
+
+func (srv *PurgeDeletedChatsService) doJob() {
+	var maxChats = viper.GetInt("schedulers.purgeDeletedChats.maxChats")
+	Logger.Debugf("Invoked purging deleted chats job with max chats limit = %v", maxChats)
+	srv.chatHandler.PurgeDeletedChats(maxChats)
+	Logger.Debugf("End of purging deleted chats job")
+}
+
+type PurgeDeletedChatsTask struct {
+	*gointerlock.GoInterval
+}
+
+func PurgeDeletedChatsScheduler(
+	redisConnector *redisV8.Client,
+	service *PurgeDeletedChatsService,
+) *PurgeDeletedChatsTask {
+	var interv = viper.GetDuration("schedulers.purgeDeletedChats.interval")
+	Logger.Infof("Created PurgeDeletedChatsScheduler with interval %v", interv)
+	return &PurgeDeletedChatsTask{&gointerlock.GoInterval{
+		Name:           "deletedChatsPurger",
+		Interval:       interv,
+		Arg:            service.doJob,
+		RedisConnector: redisConnector,
+	}}
+}
@@ -0,0 +1,45 @@
+package redis
+
+import (
+	"context"
+	"github.com/ehsaniara/gointerlock"
+	redisV8 "github.com/go-redis/redis/v8"
+	"github.com/spf13/viper"
+	"nkonev.name/chat/handlers"
+	. "nkonev.name/chat/logger"
+)
+
+type SendScheduledMessagesService struct {
+	messageHandler *handlers.MessageHandler
+}
+
+func NewSendScheduledMessagesService(messageHandler *handlers.MessageHandler) *SendScheduledMessagesService {
+	return &SendScheduledMessagesService{
+		messageHandler: messageHandler,
+	}
+}
+
+func (srv *SendScheduledMessagesService) doJob() {
+	var maxMessages = viper.GetInt("schedulers.scheduledMessages.maxMessages")
+	Logger.Debugf("Invoked sending scheduled messages job with max messages limit = %v", maxMessages)
+	srv.messageHandler.SendDueScheduledMessages(context.Background(), maxMessages)
+	Logger.Debugf("End of sending scheduled messages job")
+}
+
+type SendScheduledMessagesTask struct {
+	*gointerlock.GoInterval
+}
+
+func SendScheduledMessagesScheduler(
+	redisConnector *redisV8.Client,
+	service *SendScheduledMessagesService,
+) *SendScheduledMessagesTask {
+	var interv = viper.GetDuration("schedulers.scheduledMessages.interval")
+	Logger.Infof("Created SendScheduledMessagesScheduler with interval %v", interv)
+	return &SendScheduledMessagesTask{&gointerlock.GoInterval{
+		Name:           "scheduledMessagesSender",
+		Interval:       interv,
+		Arg:            service.doJob,
+		RedisConnector: redisConnector,
+	}}
+}
@@ -0,0 +1,285 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/guregu/null"
+	"github.com/spf13/viper"
+	"golang.org/x/net/html"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"nkonev.name/chat/db"
+	"nkonev.name/chat/utils"
+	"regexp"
+	"strings"
+)
+
+const maxLinkPreviewRedirects = 3
+const linkPreviewUserAgent = "Mozilla/5.0 (compatible; ChatLinkPreview/1.0)"
+const linkPreviewAcceptedMimeType = "text/html"
+const oembedContentType = "application/json+oembed"
+
+// the lengths of chat_link_preview columns
+const (
+	maxLinkLength             = 2048
+	maxLinkPreviewTitle       = 512
+	maxLinkPreviewDescription = 1024
+	maxLinkPreviewSiteName    = 256
+)
+
+var linkRegexp = regexp.MustCompile(`https?://[^\s<>"'` + "`" + `]+`)
+
+// LinkPreviewFetcher reads OpenGraph and oEmbed metadata of the links the users write.
+// The links are untrusted, so it goes only to the allowed hosts, never to the private addresses, and reads a limited part of the response
+type LinkPreviewFetcher struct {
+	client       *http.Client
+	allowedHosts []regexp.Regexp
+	maxBodySize  int64
+}
+
+func NewLinkPreviewFetcher() *LinkPreviewFetcher {
+	allowPrivateAddresses := viper.GetBool("linkPreviews.allowPrivateAddresses")
+	timeout := viper.GetDuration("linkPreviews.timeout")
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: publicAddressControl(allowPrivateAddresses),
+	}
+	fetcher := &LinkPreviewFetcher{
+		allowedHosts: utils.StringsToRegexpArray(viper.GetStringSlice("linkPreviews.allowedHosts")),
+		maxBodySize:  viper.GetInt64("linkPreviews.maxBodySize"),
+	}
+	fetcher.client = &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			// the proxy would connect instead of our dialer
+			Proxy:               nil,
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+			MaxIdleConns:        10,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxLinkPreviewRedirects {
+				return errors.New("too many redirects")
+			}
+			return fetcher.checkUrl(req.URL)
+		},
+	}
+	return fetcher
+}
+
+func (f *LinkPreviewFetcher) checkUrl(u *url.URL) error {
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("scheme %v is not allowed", u.Scheme)
+	}
+	host := strings.ToLower(u.Hostname())
+	for _, allowed := range f.allowedHosts {
+		if allowed.MatchString(host) {
+			return nil
+		}
+	}
+	return fmt.Errorf("host %v is not allowed", host)
+}
+
+// ExtractLinks returns up to max distinct http(s) links of the message's html in their order
+func ExtractLinks(text string, max int) []string {
+	var links = []string{}
+	var seen = map[string]bool{}
+	for _, link := range linkRegexp.FindAllString(html.UnescapeString(text), -1) {
+		if len(links) >= max {
+			break
+		}
+		link = strings.TrimRight(link, ".,;:!?)]}")
+		if len(link) > maxLinkLength || seen[link] {
+			continue
+		}
+		seen[link] = true
+		links = append(links, link)
+	}
+	return links
+}
+
+// Fetch returns the successful preview or the error if the page is unreachable, not allowed or has no metadata
+func (f *LinkPreviewFetcher) Fetch(ctx context.Context, link string) (*db.LinkPreview, error) {
+	u, err := url.Parse(link)
+	if err != nil {
+		return nil, err
+	}
+	if err := f.checkUrl(u); err != nil {
+		return nil, err
+	}
+	resp, err := f.get(ctx, u.String(), linkPreviewAcceptedMimeType)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type")); mediaType != linkPreviewAcceptedMimeType {
+		return nil, fmt.Errorf("unexpected content type %v", mediaType)
+	}
+
+	// the redirects could change the base of the relative urls
+	base := resp.Request.URL
+	meta := parseHtmlHead(io.LimitReader(resp.Body, f.maxBodySize))
+	preview := &db.LinkPreview{
+		Url:         link,
+		Success:     true,
+		Title:       firstNotEmpty(meta.properties["og:title"], meta.properties["twitter:title"], meta.title),
+		Description: firstNotEmpty(meta.properties["og:description"], meta.properties["twitter:description"], meta.properties["description"]),
+		ImageUrl:    resolveHttpUrl(base, firstNotEmpty(meta.properties["og:image"], meta.properties["twitter:image"]).String),
+		SiteName:    firstNotEmpty(meta.properties["og:site_name"]),
+	}
+
+	if meta.oembedUrl != "" && (!preview.Title.Valid || !preview.ImageUrl.Valid) {
+		if oembedUrl := resolveHttpUrl(base, meta.oembedUrl); oembedUrl.Valid {
+			if oembed, err := f.fetchOembed(ctx, oembedUrl.String); err == nil {
+				preview.Title = firstNotEmpty(preview.Title.String, oembed.Title)
+				preview.ImageUrl = firstNotEmpty(preview.ImageUrl.String, resolveHttpUrl(base, oembed.ThumbnailUrl).String)
+				preview.SiteName = firstNotEmpty(preview.SiteName.String, oembed.ProviderName)
+			}
+		}
+	}
+
+	if !preview.Title.Valid && !preview.Description.Valid {
+		return nil, errors.New("the page has no metadata")
+	}
+	if !preview.SiteName.Valid {
+		preview.SiteName = null.StringFrom(base.Hostname())
+	}
+	preview.Title = truncate(preview.Title, maxLinkPreviewTitle)
+	preview.Description = truncate(preview.Description, maxLinkPreviewDescription)
+	preview.SiteName = truncate(preview.SiteName, maxLinkPreviewSiteName)
+	if len(preview.ImageUrl.String) > maxLinkLength {
+		preview.ImageUrl = null.String{}
+	}
+	return preview, nil
+}
+
+func (f *LinkPreviewFetcher) get(ctx context.Context, link string, accept string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, link, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", linkPreviewUserAgent)
+	req.Header.Set("Accept", accept)
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		resp.Body.Close()
+		return nil, fmt.Errorf("unexpected status %v", resp.StatusCode)
+	}
+	return resp, nil
+}
+
+type oembedDto struct {
+	Title        string `json:"title"`
+	ThumbnailUrl string `json:"thumbnail_url"`
+	ProviderName string `json:"provider_name"`
+}
+
+// fetchOembed follows the page's discovery link, the provider is checked against the allowed hosts as well
+func (f *LinkPreviewFetcher) fetchOembed(ctx context.Context, link string) (*oembedDto, error) {
+	u, err := url.Parse(link)
+	if err != nil {
+		return nil, err
+	}
+	if err := f.checkUrl(u); err != nil {
+		return nil, err
+	}
+	resp, err := f.get(ctx, u.String(), "application/json")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	var oembed = new(oembedDto)
+	if err := json.NewDecoder(io.LimitReader(resp.Body, f.maxBodySize)).Decode(oembed); err != nil {
+		return nil, err
+	}
+	return oembed, nil
+}
+
+type htmlHeadMeta struct {
+	title      string
+	properties map[string]string // the meta tags by their property or name
+	oembedUrl  string
+}
+
+// parseHtmlHead reads the tags until the body, the page can be cut by the size limit
+func parseHtmlHead(body io.Reader) *htmlHeadMeta {
+	meta := &htmlHeadMeta{properties: map[string]string{}}
+	tokenizer := html.NewTokenizer(body)
+	for {
+		tokenType := tokenizer.Next()
+		switch tokenType {
+		case html.ErrorToken:
+			return meta
+		case html.EndTagToken:
+			if name, _ := tokenizer.TagName(); string(name) == "head" {
+				return meta
+			}
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, hasAttributes := tokenizer.TagName()
+			var attributes = map[string]string{}
+			for hasAttributes {
+				var key, value []byte
+				key, value, hasAttributes = tokenizer.TagAttr()
+				attributes[string(key)] = string(value)
+			}
+			switch string(name) {
+			case "body":
+				return meta
+			case "title":
+				if tokenType == html.StartTagToken && tokenizer.Next() == html.TextToken {
+					meta.title = string(tokenizer.Text())
+				}
+			case "meta":
+				key := attributes["property"]
+				if key == "" {
+					key = attributes["name"]
+				}
+				key = strings.ToLower(key)
+				// the first one wins, e.g. of several og:image
+				if _, ok := meta.properties[key]; key != "" && !ok {
+					meta.properties[key] = attributes["content"]
+				}
+			case "link":
+				if strings.EqualFold(attributes["rel"], "alternate") && strings.EqualFold(attributes["type"], oembedContentType) && meta.oembedUrl == "" {
+					meta.oembedUrl = attributes["href"]
+				}
+			}
+		}
+	}
+}
+
+func firstNotEmpty(values ...string) null.String {
+	for _, value := range values {
+		if trimmed := strings.TrimSpace(value); trimmed != "" {
+			return null.StringFrom(trimmed)
+		}
+	}
+	return null.String{}
+}
+
+func resolveHttpUrl(base *url.URL, reference string) null.String {
+	if reference == "" {
+		return null.String{}
+	}
+	u, err := base.Parse(reference)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return null.String{}
+	}
+	return null.StringFrom(u.String())
+}
+
+func truncate(value null.String, maxLength int) null.String {
+	runes := []rune(value.String)
+	if len(runes) > maxLength {
+		return null.StringFrom(string(runes[:maxLength-1]) + "…")
+	}
+	return value
+}
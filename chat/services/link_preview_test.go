@@ -0,0 +1,131 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const openGraphPage = `<!DOCTYPE html>
+<html>
+<head>
+	<title>Fallback title</title>
+	<meta property="og:title" content="Elephants &amp; mice">
+	<meta property="og:description" content="Why elephants never forget">
+	<meta property="og:image" content="/images/elephant.jpg">
+	<meta property="og:site_name" content="Zoo">
+</head>
+<body><meta property="og:title" content="Not from the head"></body>
+</html>`
+
+func newTestLinkPreviewFetcher(allowPrivateAddresses bool, maxBodySize int64) *LinkPreviewFetcher {
+	viper.Set("linkPreviews.allowedHosts", []string{"^127\\.0\\.0\\.1$"})
+	viper.Set("linkPreviews.allowPrivateAddresses", allowPrivateAddresses)
+	viper.Set("linkPreviews.timeout", 2*time.Second)
+	viper.Set("linkPreviews.maxBodySize", maxBodySize)
+	return NewLinkPreviewFetcher()
+}
+
+func startLinkPreviewServer() *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/og", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		fmt.Fprint(w, openGraphPage)
+	})
+	mux.HandleFunc("/video", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprintf(w, `<html><head><title>Video page</title><link rel="alternate" type="application/json+oembed" href="/oembed?url=%v"></head></html>`, r.URL.Path)
+	})
+	mux.HandleFunc("/oembed", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"title": "Video title", "thumbnail_url": "/thumbnail.jpg", "provider_name": "Tube"}`)
+	})
+	mux.HandleFunc("/big", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, `<html><head><!--`+strings.Repeat("x", 4096)+`--><meta property="og:title" content="Too far"></head></html>`)
+	})
+	mux.HandleFunc("/image", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		fmt.Fprint(w, "png")
+	})
+	mux.HandleFunc("/redirect", func(w http.ResponseWriter, r *http.Request) {
+		// localhost isn't in the allowed hosts
+		http.Redirect(w, r, strings.Replace("http://"+r.Host+"/og", "127.0.0.1", "localhost", 1), http.StatusFound)
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestExtractLinks(t *testing.T) {
+	text := `<p>See <a href="https://example.com/a?b=1&amp;c=2">this</a>, https://example.com/a?b=1&amp;c=2 and (http://example.org/x). ftp://example.net</p>`
+	assert.Equal(t, []string{"https://example.com/a?b=1&c=2", "http://example.org/x"}, ExtractLinks(text, 3))
+	assert.Equal(t, []string{"https://example.com/a?b=1&c=2"}, ExtractLinks(text, 1))
+	assert.Equal(t, []string{}, ExtractLinks("no links", 3))
+}
+
+func TestLinkPreviewOpenGraph(t *testing.T) {
+	server := startLinkPreviewServer()
+	defer server.Close()
+	fetcher := newTestLinkPreviewFetcher(true, 64*1024)
+
+	preview, err := fetcher.Fetch(context.Background(), server.URL+"/og")
+	assert.Nil(t, err)
+	assert.True(t, preview.Success)
+	assert.Equal(t, server.URL+"/og", preview.Url)
+	assert.Equal(t, "Elephants & mice", preview.Title.String)
+	assert.Equal(t, "Why elephants never forget", preview.Description.String)
+	assert.Equal(t, server.URL+"/images/elephant.jpg", preview.ImageUrl.String)
+	assert.Equal(t, "Zoo", preview.SiteName.String)
+}
+
+func TestLinkPreviewOembed(t *testing.T) {
+	server := startLinkPreviewServer()
+	defer server.Close()
+	fetcher := newTestLinkPreviewFetcher(true, 64*1024)
+
+	preview, err := fetcher.Fetch(context.Background(), server.URL+"/video")
+	assert.Nil(t, err)
+	assert.Equal(t, "Video page", preview.Title.String)
+	assert.Equal(t, server.URL+"/thumbnail.jpg", preview.ImageUrl.String)
+	assert.Equal(t, "Tube", preview.SiteName.String)
+}
+
+func TestLinkPreviewLimits(t *testing.T) {
+	server := startLinkPreviewServer()
+	defer server.Close()
+	fetcher := newTestLinkPreviewFetcher(true, 1024)
+
+	_, err := fetcher.Fetch(context.Background(), server.URL+"/big")
+	assert.EqualError(t, err, "the page has no metadata")
+
+	_, err = fetcher.Fetch(context.Background(), server.URL+"/image")
+	assert.EqualError(t, err, "unexpected content type image/png")
+
+	_, err = fetcher.Fetch(context.Background(), server.URL+"/redirect")
+	assert.ErrorContains(t, err, "host localhost is not allowed")
+
+	_, err = fetcher.Fetch(context.Background(), strings.Replace(server.URL, "127.0.0.1", "localhost", 1)+"/og")
+	assert.EqualError(t, err, "host localhost is not allowed")
+
+	_, err = fetcher.Fetch(context.Background(), "file:///etc/passwd")
+	assert.EqualError(t, err, "scheme file is not allowed")
+}
+
+func TestLinkPreviewPrivateAddress(t *testing.T) {
+	server := startLinkPreviewServer()
+	defer server.Close()
+	fetcher := newTestLinkPreviewFetcher(false, 64*1024)
+
+	_, err := fetcher.Fetch(context.Background(), server.URL+"/og")
+	assert.ErrorContains(t, err, "127.0.0.1 is not a public address")
+
+	assert.Nil(t, checkPublicAddress("93.184.216.34:443"))
+	for _, address := range []string{"10.0.0.1:80", "192.168.1.1:80", "169.254.169.254:80", "100.64.0.1:80", "[::1]:80", "[fd00::1]:80", "0.0.0.0:80"} {
+		assert.Error(t, checkPublicAddress(address), address)
+	}
+}
@@ -20,6 +20,10 @@ type Notifications interface {
 	NotifyAboutProfileChanged(user *dto.User)
 	NotifyAboutMessageTyping(c echo.Context, chatId int64, user *dto.User)
 	NotifyAboutMessageBroadcast(c echo.Context, chatId, userId int64, login, text string)
+	NotifyAboutPinnedMessageChanged(c echo.Context, userIds []int64, chatId int64, message *dto.DisplayMessageDto)
+	NotifyAboutMessageRead(c echo.Context, userIds []int64, chatId int64, messageRead *dto.MessageReadDto)
+	NotifyAboutReactionChanged(c echo.Context, userIds []int64, chatId int64, reactionChanged *dto.ReactionChangedDto)
+	NotifyAboutMention(c echo.Context, userIds []int64, mention *dto.MentionDto)
 	ChatNotifyMessageCount(userIds []int64, c echo.Context, chatId int64, tx *db.Tx)
 	ChatNotifyAllUnreadMessageCount(userIds []int64, c echo.Context, tx *db.Tx)
 }
@@ -62,6 +66,28 @@ func (not *notifictionsImpl) NotifyAboutDeleteChat(c echo.Context, chatId int64,
 
 func chatNotifyCommon(userIds []int64, not *notifictionsImpl, c echo.Context, newChatDto *dto.ChatDtoWithAdmin, eventType string, changingParticipantPage int, tx *db.Tx) {
 	GetLogEntry(c.Request().Context()).Debugf("Sending notification about %v the chat to participants: %v", eventType, userIds)
+	if len(userIds) == 0 {
+		return
+	}
+
+	webhookEvent := dto.WebhookEvent{
+		EventType: eventType,
+		ChatId:    newChatDto.Id,
+		UserIds:   userIds,
+	}
+	if eventType != "chat_deleted" {
+		webhookEvent.ChatNotification = dto.NewWebhookChatDto(&newChatDto.BaseChatDto)
+	}
+	not.notifyWebhooks(c, webhookEvent)
+
+	var pinnedBy = map[int64]bool{}
+	if eventType != "chat_deleted" {
+		var err error
+		if pinnedBy, err = tx.GetChatPinnedBy(newChatDto.Id, userIds); err != nil {
+			GetLogEntry(c.Request().Context()).Errorf("error during checking is chat pinned: %s", err)
+			return
+		}
+	}
 
 	for _, participantId := range userIds {
 		if eventType == "chat_deleted" {
@@ -81,9 +107,9 @@ func chatNotifyCommon(userIds []int64, not *notifictionsImpl, c echo.Context, ne
 				continue
 			}
 
-			admin, err := tx.IsAdmin(participantId, newChatDto.Id)
+			role, err := tx.GetParticipantRole(participantId, newChatDto.Id)
 			if err != nil {
-				GetLogEntry(c.Request().Context()).Errorf("error during checking is admin for userId=%v: %s", participantId, err)
+				GetLogEntry(c.Request().Context()).Errorf("error during getting role for userId=%v: %s", participantId, err)
 				continue
 			}
 
@@ -93,8 +119,14 @@ func chatNotifyCommon(userIds []int64, not *notifictionsImpl, c echo.Context, ne
 				continue
 			}
 
+			unreadMentions, err := tx.GetUnreadMentionsCount(newChatDto.Id, participantId)
+			if err != nil {
+				GetLogEntry(c.Request().Context()).Errorf("error during get unread mentions for userId=%v: %s", participantId, err)
+				continue
+			}
+
 			// see also handlers/chat.go:199 convertToDto()
-			copied.SetPersonalizedFields(admin, unreadMessages)
+			copied.SetPersonalizedFields(role, pinnedBy[participantId], unreadMessages, unreadMentions)
 
 			copied.ChangingParticipantsPage = changingParticipantPage
 
@@ -105,7 +137,7 @@ func chatNotifyCommon(userIds []int64, not *notifictionsImpl, c echo.Context, ne
 			err = not.rabbitPublisher.Publish(dto.GlobalEvent{
 				UserId:           participantId,
 				EventType:        eventType,
-				ChatNotification: newChatDto,
+				ChatNotification: copied,
 			})
 			if err != nil {
 				GetLogEntry(c.Request().Context()).Errorf("Error during sending to rabbitmq : %s", err)
@@ -159,7 +191,46 @@ func (not *notifictionsImpl) ChatNotifyAllUnreadMessageCount(userIds []int64, c
 	}
 }
 
+// mentions are sent as global events, so the user gets them without subscription to the chat
+func (not *notifictionsImpl) NotifyAboutMention(c echo.Context, userIds []int64, mention *dto.MentionDto) {
+	for _, participantId := range userIds {
+		GetLogEntry(c.Request().Context()).Debugf("Sending notification about mention to participantChannel: %v", participantId)
+
+		err := not.rabbitPublisher.Publish(dto.GlobalEvent{
+			UserId:              participantId,
+			EventType:           "mention",
+			MentionNotification: mention,
+		})
+		if err != nil {
+			GetLogEntry(c.Request().Context()).Errorf("Error during sending to rabbitmq : %s", err)
+		}
+	}
+}
+
+// notifyWebhooks publishes the event once for the chat's outgoing webhooks, regardless of how many participants get it
+func (not *notifictionsImpl) notifyWebhooks(c echo.Context, event dto.WebhookEvent) {
+	if err := not.rabbitPublisher.PublishToWebhooks(event); err != nil {
+		GetLogEntry(c.Request().Context()).Errorf("Error during sending to rabbitmq : %s", err)
+	}
+}
+
 func messageNotifyCommon(c echo.Context, userIds []int64, chatId int64, message *dto.DisplayMessageDto, not *notifictionsImpl, eventType string) {
+	webhookEvent := dto.WebhookEvent{
+		EventType: eventType,
+		ChatId:    chatId,
+	}
+	if eventType == "message_deleted" {
+		webhookEvent.MessageDeletedNotification = &dto.MessageDeletedDto{
+			Id:     message.Id,
+			ChatId: message.ChatId,
+		}
+	} else {
+		// canEdit is the only field of a participant, the rest are shared
+		withoutPersonalized := *message
+		withoutPersonalized.CanEdit = false
+		webhookEvent.MessageNotification = &withoutPersonalized
+	}
+	not.notifyWebhooks(c, webhookEvent)
 
 	for _, participantId := range userIds {
 		if eventType == "message_deleted" {
@@ -209,6 +280,10 @@ func (not *notifictionsImpl) NotifyAboutEditMessage(c echo.Context, userIds []in
 	messageNotifyCommon(c, userIds, chatId, message, not, "message_edited")
 }
 
+func (not *notifictionsImpl) NotifyAboutPinnedMessageChanged(c echo.Context, userIds []int64, chatId int64, message *dto.DisplayMessageDto) {
+	messageNotifyCommon(c, userIds, chatId, message, not, "pinned_message_changed")
+}
+
 func (not *notifictionsImpl) NotifyAboutMessageTyping(c echo.Context, chatId int64, user *dto.User) {
 	if user == nil {
 		GetLogEntry(c.Request().Context()).Errorf("user cannot be null")
@@ -225,6 +300,11 @@ func (not *notifictionsImpl) NotifyAboutMessageTyping(c echo.Context, chatId int
 		Login:         user.Login,
 		ParticipantId: user.Id,
 	}
+	not.notifyWebhooks(c, dto.WebhookEvent{
+		EventType:              "user_typing",
+		ChatId:                 chatId,
+		UserTypingNotification: &ut,
+	})
 
 	for _, participantId := range participantIds {
 		err := not.rabbitPublisher.Publish(dto.ChatEvent{
@@ -268,6 +348,11 @@ func (not *notifictionsImpl) NotifyAboutMessageBroadcast(c echo.Context, chatId,
 		UserId: userId,
 		Text:   text,
 	}
+	not.notifyWebhooks(c, dto.WebhookEvent{
+		EventType:                    "user_broadcast",
+		ChatId:                       chatId,
+		MessageBroadcastNotification: &ut,
+	})
 
 	participantIds, err := not.db.GetAllParticipantIds(chatId)
 	if err != nil {
@@ -288,3 +373,41 @@ func (not *notifictionsImpl) NotifyAboutMessageBroadcast(c echo.Context, chatId,
 	}
 
 }
+
+func (not *notifictionsImpl) NotifyAboutReactionChanged(c echo.Context, userIds []int64, chatId int64, reactionChanged *dto.ReactionChangedDto) {
+	not.notifyWebhooks(c, dto.WebhookEvent{
+		EventType:                   "reaction_changed",
+		ChatId:                      chatId,
+		ReactionChangedNotification: reactionChanged,
+	})
+	for _, participantId := range userIds {
+		err := not.rabbitPublisher.Publish(dto.ChatEvent{
+			EventType:                   "reaction_changed",
+			ReactionChangedNotification: reactionChanged,
+			UserId:                      participantId,
+			ChatId:                      chatId,
+		})
+		if err != nil {
+			GetLogEntry(c.Request().Context()).Errorf("Error during sending to rabbitmq : %s", err)
+		}
+	}
+}
+
+func (not *notifictionsImpl) NotifyAboutMessageRead(c echo.Context, userIds []int64, chatId int64, messageRead *dto.MessageReadDto) {
+	not.notifyWebhooks(c, dto.WebhookEvent{
+		EventType:               "message_read",
+		ChatId:                  chatId,
+		MessageReadNotification: messageRead,
+	})
+	for _, participantId := range userIds {
+		err := not.rabbitPublisher.Publish(dto.ChatEvent{
+			EventType:               "message_read",
+			MessageReadNotification: messageRead,
+			UserId:                  participantId,
+			ChatId:                  chatId,
+		})
+		if err != nil {
+			GetLogEntry(c.Request().Context()).Errorf("Error during sending to rabbitmq : %s", err)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package services
+
+import (
+	"fmt"
+	"net"
+	"syscall"
+)
+
+// carrier-grade NAT, net.IP.IsPrivate doesn't cover it
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// publicAddressControl is net.Dialer's Control for the requests to the urls the users give.
+// It's called with the resolved address, so the host can't be rebound to the private one after the check
+func publicAddressControl(allowPrivateAddresses bool) func(network, address string, conn syscall.RawConn) error {
+	return func(network, address string, conn syscall.RawConn) error {
+		if allowPrivateAddresses {
+			return nil
+		}
+		return checkPublicAddress(address)
+	}
+}
+
+func checkPublicAddress(address string) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return fmt.Errorf("%v is not an ip address", host)
+	}
+	return checkPublicIp(ip)
+}
+
+func checkPublicIp(ip net.IP) error {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || sharedAddressSpace.Contains(ip) {
+		return fmt.Errorf("%v is not a public address", ip)
+	}
+	return nil
+}
+
+// CheckPublicHost resolves the host and fails if any of its addresses isn't public, it's for the early feedback,
+// the requests are guarded by publicAddressControl anyway
+func CheckPublicHost(host string) error {
+	ips, err := net.LookupIP(host)
+	if err != nil {
+		return err
+	}
+	for _, ip := range ips {
+		if err := checkPublicIp(ip); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package services
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/guregu/null"
+	"github.com/spf13/viper"
+	"io"
+	"net"
+	"net/http"
+	"nkonev.name/chat/db"
+	. "nkonev.name/chat/logger"
+	"time"
+)
+
+const WebhookEventHeader = "X-Chat-Event"
+const WebhookSignatureHeader = "X-Chat-Signature-256"
+
+// WebhookDispatcher POSTs the events of chat to its outgoing webhooks.
+// The attempts are made by the bounded workers, so a slow or unreachable webhook doesn't hold the others
+type WebhookDispatcher struct {
+	db             db.DB
+	client         *http.Client
+	maxAttempts    int
+	initialBackoff time.Duration
+	attempts       chan *webhookAttempt
+}
+
+type webhookAttempt struct {
+	hook      *db.ChatWebhook
+	eventType string
+	payload   []byte
+	number    int
+	backoff   time.Duration // before the next attempt
+}
+
+func NewWebhookDispatcher(dbR db.DB) *WebhookDispatcher {
+	timeout := viper.GetDuration("webhooks.timeout")
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: publicAddressControl(viper.GetBool("webhooks.allowPrivateAddresses")),
+	}
+	d := &WebhookDispatcher{
+		db: dbR,
+		client: &http.Client{
+			Timeout: timeout,
+			Transport: &http.Transport{
+				// the proxy would connect instead of our dialer
+				Proxy:               nil,
+				DialContext:         dialer.DialContext,
+				TLSHandshakeTimeout: timeout,
+			},
+		},
+		maxAttempts:    viper.GetInt("webhooks.maxAttempts"),
+		initialBackoff: viper.GetDuration("webhooks.initialBackoff"),
+		attempts:       make(chan *webhookAttempt, viper.GetInt("webhooks.queueSize")),
+	}
+	for i := 0; i < viper.GetInt("webhooks.workers"); i++ {
+		go func() {
+			for attempt := range d.attempts {
+				d.deliver(attempt)
+			}
+		}()
+	}
+	return d
+}
+
+// SignWebhookPayload is hex of HMAC-SHA256 of the body with the webhook's secret, the receiver computes the same and compares
+func SignWebhookPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Dispatch queues the payload for every webhook of the chat subscribed to the event type,
+// it waits only when all the workers are busy and the queue is full
+func (d *WebhookDispatcher) Dispatch(chatId int64, eventType string, payload []byte) {
+	hooks, err := d.db.GetChatWebhooksForEvent(chatId, eventType)
+	if err != nil {
+		Logger.Errorf("Error during getting webhooks of chat %v %v", chatId, err)
+		return
+	}
+	for _, hook := range hooks {
+		d.attempts <- &webhookAttempt{hook: hook, eventType: eventType, payload: payload, number: 1, backoff: d.initialBackoff}
+	}
+}
+
+// deliver makes the one attempt, the next one is queued again after the backoff without holding the worker
+func (d *WebhookDispatcher) deliver(attempt *webhookAttempt) {
+	statusCode, retriable, err := d.post(attempt.hook, attempt.eventType, attempt.payload)
+	delivery := &db.ChatWebhookDelivery{
+		WebhookId: attempt.hook.Id,
+		EventType: attempt.eventType,
+		Attempt:   attempt.number,
+		Success:   err == nil,
+	}
+	if statusCode != 0 {
+		delivery.StatusCode = null.IntFrom(int64(statusCode))
+	}
+	if err != nil {
+		delivery.Error = null.StringFrom(err.Error())
+	}
+	if err := d.db.AddChatWebhookDelivery(delivery); err != nil {
+		// e.g. the webhook has been deleted meanwhile
+		Logger.Warnf("Error during logging delivery to webhook %v, stopping %v", attempt.hook.Id, err)
+		return
+	}
+	if delivery.Success || !retriable {
+		return
+	}
+	if attempt.number >= d.maxAttempts {
+		Logger.Warnf("Giving up delivering %v to webhook %v", attempt.eventType, attempt.hook.Id)
+		return
+	}
+	next := *attempt
+	next.number++
+	next.backoff *= 2
+	time.AfterFunc(attempt.backoff, func() {
+		d.attempts <- &next
+	})
+}
+
+// post returns the status code or 0 if there is no response, and is it worth to try again
+func (d *WebhookDispatcher) post(hook *db.ChatWebhook, eventType string, payload []byte) (int, bool, error) {
+	req, err := http.NewRequest(http.MethodPost, hook.Url, bytes.NewReader(payload))
+	if err != nil {
+		return 0, false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookEventHeader, eventType)
+	req.Header.Set(WebhookSignatureHeader, SignWebhookPayload(hook.Secret, payload))
+
+	response, err := d.client.Do(req)
+	if err != nil {
+		return 0, true, err
+	}
+	defer response.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(response.Body, 64*1024))
+
+	if response.StatusCode >= 200 && response.StatusCode < 300 {
+		return response.StatusCode, false, nil
+	}
+	// the client errors won't pass by themselves, except the rate limit
+	retriable := response.StatusCode >= 500 || response.StatusCode == http.StatusTooManyRequests
+	return response.StatusCode, retriable, fmt.Errorf("Unexpected status %v", response.StatusCode)
+}
@@ -1,6 +1,8 @@
 package utils
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"nkonev.name/chat/dto"
 	. "nkonev.name/chat/logger"
@@ -162,3 +164,20 @@ func GetType(aDto interface{}) string {
 	strName := fmt.Sprintf("%T", aDto)
 	return strName
 }
+
+// cursor is an opaque for the client serialized keyset of the last returned element
+func EncodeCursor(key interface{}) (string, error) {
+	bytes, err := json.Marshal(key)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
+
+func DecodeCursor(cursor string, key interface{}) error {
+	bytes, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bytes, key)
+}
@@ -16,12 +16,18 @@ type BaseChatDto struct {
 	CanDelete           null.Bool   `json:"canDelete"`
 	CanLeave            null.Bool   `json:"canLeave"`
 	UnreadMessages      int64       `json:"unreadMessages"`
+	UnreadMentions      int64       `json:"unreadMentions"`
 	CanBroadcast        bool        `json:"canBroadcast"`
 	CanVideoKick        bool        `json:"canVideoKick"`
 	CanChangeChatAdmins bool        `json:"canChangeChatAdmins"`
 	IsTetATet           bool        `json:"tetATet"`
 	CanAudioMute        bool        `json:"canAudioMute"`
 	ParticipantsCount   int         `json:"participantsCount"`
+	Pinned              bool        `json:"pinned"`
+	Visibility          string      `json:"visibility"`
+	Role                string      `json:"role"`
+	IsChannel           bool        `json:"channel"`
+	CanWriteMessage     bool        `json:"canWriteMessage"`
 }
 
 type ChatDeletedDto struct {
@@ -7,15 +7,61 @@ import (
 )
 
 type DisplayMessageDto struct {
-	Id             int64      `json:"id"`
-	Text           string     `json:"text"`
-	ChatId         int64      `json:"chatId"`
-	OwnerId        int64      `json:"ownerId"`
-	CreateDateTime time.Time  `json:"createDateTime"`
-	EditDateTime   null.Time  `json:"editDateTime"`
-	Owner          *User      `json:"owner"`
-	CanEdit        bool       `json:"canEdit"`
-	FileItemUuid   *uuid.UUID `json:"fileItemUuid"`
+	Id               int64              `json:"id"`
+	Text             string             `json:"text"`
+	ChatId           int64              `json:"chatId"`
+	OwnerId          int64              `json:"ownerId"`
+	CreateDateTime   time.Time          `json:"createDateTime"`
+	EditDateTime     null.Time          `json:"editDateTime"`
+	Owner            *User              `json:"owner"`
+	CanEdit          bool               `json:"canEdit"`
+	FileItemUuid     *uuid.UUID         `json:"fileItemUuid"`
+	ReplyToMessageId null.Int           `json:"replyToMessageId"`
+	ReplyTo          *ReplyToMessageDto `json:"replyTo"`
+	Reactions        []*ReactionDto     `json:"reactions"`
+	Pinned           bool               `json:"pinned"`
+	ReadBy           int64              `json:"readBy"`
+	ForwardedFrom    *ForwardedFromDto  `json:"forwardedFrom"`
+	Bot              bool               `json:"bot"`
+	LinkPreviews     []*LinkPreviewDto  `json:"linkPreviews"`
+}
+
+type LinkPreviewDto struct {
+	Url         string      `json:"url"`
+	Title       null.String `json:"title"`
+	Description null.String `json:"description"`
+	ImageUrl    null.String `json:"imageUrl"`
+	SiteName    null.String `json:"siteName"`
+}
+
+type ForwardedFromDto struct {
+	ChatId    int64 `json:"chatId"`
+	MessageId int64 `json:"messageId"`
+	OwnerId   int64 `json:"ownerId"`
+	Owner     *User `json:"owner"`
+}
+
+type ReplyToMessageDto struct {
+	Id      int64  `json:"id"`
+	OwnerId int64  `json:"ownerId"`
+	Owner   *User  `json:"owner"`
+	Text    string `json:"text"`
+}
+
+type MessageReadDto struct {
+	ChatId        int64 `json:"chatId"`
+	LastMessageId int64 `json:"lastMessageId"`
+	UserId        int64 `json:"userId"`
+}
+
+type ReactionDto struct {
+	Reaction string `json:"reaction"`
+	Count    int64  `json:"count"`
+}
+
+type ReactionChangedDto struct {
+	MessageId int64          `json:"messageId"`
+	Reactions []*ReactionDto `json:"reactions"`
 }
 
 type MessageDeletedDto struct {
@@ -34,6 +80,15 @@ type MessageBroadcastNotification struct {
 	Text   string `json:"text"`
 }
 
+type MentionDto struct {
+	ChatId         int64     `json:"chatId"`
+	MessageId      int64     `json:"messageId"`
+	OwnerId        int64     `json:"ownerId"`
+	Owner          *User     `json:"owner"`
+	Text           string    `json:"text"`
+	CreateDateTime time.Time `json:"createDateTime"`
+}
+
 type AllUnreadMessages struct {
 	MessagesCount int64 `json:"allUnreadMessages"`
 }
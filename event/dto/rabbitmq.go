@@ -13,6 +13,8 @@ type ChatEvent struct {
 	MessageDeletedNotification   *MessageDeletedDto            `json:"messageDeletedNotification"`
 	UserTypingNotification       *UserTypingNotification       `json:"userTypingNotification"`
 	MessageBroadcastNotification *MessageBroadcastNotification `json:"messageBroadcastNotification"`
+	ReactionChangedNotification  *ReactionChangedDto           `json:"reactionChangedNotification"`
+	MessageReadNotification      *MessageReadDto               `json:"messageReadNotification"`
 }
 
 func (ChatEvent) Name() eventbus.EventName {
@@ -31,6 +33,7 @@ type GlobalEvent struct {
 	UnreadMessagesNotification    *ChatUnreadMessageChanged     `json:"unreadMessagesNotification"`
 	AllUnreadMessagesNotification *AllUnreadMessages            `json:"allUnreadMessagesNotification"`
 	VideoCallRecordingEvent       *VideoCallRecordingChangedDto `json:"videoCallRecordingEvent"`
+	MentionNotification           *MentionDto                   `json:"mentionNotification"`
 }
 
 func (GlobalEvent) Name() eventbus.EventName {
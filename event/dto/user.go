@@ -11,4 +11,5 @@ type User struct {
 type UserWithAdmin struct {
 	User
 	Admin  bool      `json:"admin"`
+	Role   string    `json:"role"`
 }
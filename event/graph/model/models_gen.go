@@ -27,6 +27,7 @@ type ChatDto struct {
 	CanDelete                *bool            `json:"canDelete"`
 	CanLeave                 *bool            `json:"canLeave"`
 	UnreadMessages           int64            `json:"unreadMessages"`
+	UnreadMentions           int64            `json:"unreadMentions"`
 	CanBroadcast             bool             `json:"canBroadcast"`
 	CanVideoKick             bool             `json:"canVideoKick"`
 	CanChangeChatAdmins      bool             `json:"canChangeChatAdmins"`
@@ -35,6 +36,11 @@ type ChatDto struct {
 	Participants             []*UserWithAdmin `json:"participants"`
 	ParticipantsCount        int              `json:"participantsCount"`
 	ChangingParticipantsPage int              `json:"changingParticipantsPage"`
+	Pinned                   bool             `json:"pinned"`
+	Visibility               string           `json:"visibility"`
+	Role                     string           `json:"role"`
+	Channel                  bool             `json:"channel"`
+	CanWriteMessage          bool             `json:"canWriteMessage"`
 }
 
 type ChatEvent struct {
@@ -43,6 +49,8 @@ type ChatEvent struct {
 	MessageDeletedEvent   *MessageDeletedDto            `json:"messageDeletedEvent"`
 	UserTypingEvent       *UserTypingDto                `json:"userTypingEvent"`
 	MessageBroadcastEvent *MessageBroadcastNotification `json:"messageBroadcastEvent"`
+	ReactionChangedEvent  *ReactionChangedDto           `json:"reactionChangedEvent"`
+	MessageReadEvent      *MessageReadDto               `json:"messageReadEvent"`
 }
 
 type ChatUnreadMessageChanged struct {
@@ -51,15 +59,30 @@ type ChatUnreadMessageChanged struct {
 }
 
 type DisplayMessageDto struct {
-	ID             int64      `json:"id"`
-	Text           string     `json:"text"`
-	ChatID         int64      `json:"chatId"`
-	OwnerID        int64      `json:"ownerId"`
-	CreateDateTime time.Time  `json:"createDateTime"`
-	EditDateTime   *time.Time `json:"editDateTime"`
-	Owner          *User      `json:"owner"`
-	CanEdit        bool       `json:"canEdit"`
-	FileItemUUID   *uuid.UUID `json:"fileItemUuid"`
+	ID               int64              `json:"id"`
+	Text             string             `json:"text"`
+	ChatID           int64              `json:"chatId"`
+	OwnerID          int64              `json:"ownerId"`
+	CreateDateTime   time.Time          `json:"createDateTime"`
+	EditDateTime     *time.Time         `json:"editDateTime"`
+	Owner            *User              `json:"owner"`
+	CanEdit          bool               `json:"canEdit"`
+	FileItemUUID     *uuid.UUID         `json:"fileItemUuid"`
+	ReplyToMessageID *int64             `json:"replyToMessageId"`
+	ReplyTo          *ReplyToMessageDto `json:"replyTo"`
+	Reactions        []*ReactionDto     `json:"reactions"`
+	Pinned           bool               `json:"pinned"`
+	ReadBy           int64              `json:"readBy"`
+	ForwardedFrom    *ForwardedFromDto  `json:"forwardedFrom"`
+	Bot              bool               `json:"bot"`
+	LinkPreviews     []*LinkPreviewDto  `json:"linkPreviews"`
+}
+
+type ForwardedFromDto struct {
+	ChatID    int64 `json:"chatId"`
+	MessageID int64 `json:"messageId"`
+	OwnerID   int64 `json:"ownerId"`
+	Owner     *User `json:"owner"`
 }
 
 type GlobalEvent struct {
@@ -73,6 +96,24 @@ type GlobalEvent struct {
 	VideoParticipantDialEvent     *VideoDialChanges         `json:"videoParticipantDialEvent"`
 	UnreadMessagesNotification    *ChatUnreadMessageChanged `json:"unreadMessagesNotification"`
 	AllUnreadMessagesNotification *AllUnreadMessages        `json:"allUnreadMessagesNotification"`
+	MentionNotification           *MentionDto               `json:"mentionNotification"`
+}
+
+type LinkPreviewDto struct {
+	URL         string  `json:"url"`
+	Title       *string `json:"title"`
+	Description *string `json:"description"`
+	ImageURL    *string `json:"imageUrl"`
+	SiteName    *string `json:"siteName"`
+}
+
+type MentionDto struct {
+	ChatID         int64     `json:"chatId"`
+	MessageID      int64     `json:"messageId"`
+	OwnerID        int64     `json:"ownerId"`
+	Owner          *User     `json:"owner"`
+	Text           string    `json:"text"`
+	CreateDateTime time.Time `json:"createDateTime"`
 }
 
 type MessageBroadcastNotification struct {
@@ -86,6 +127,29 @@ type MessageDeletedDto struct {
 	ChatID int64 `json:"chatId"`
 }
 
+type MessageReadDto struct {
+	ChatID        int64 `json:"chatId"`
+	LastMessageID int64 `json:"lastMessageId"`
+	UserID        int64 `json:"userId"`
+}
+
+type ReactionChangedDto struct {
+	MessageID int64          `json:"messageId"`
+	Reactions []*ReactionDto `json:"reactions"`
+}
+
+type ReactionDto struct {
+	Reaction string `json:"reaction"`
+	Count    int64  `json:"count"`
+}
+
+type ReplyToMessageDto struct {
+	ID      int64  `json:"id"`
+	OwnerID int64  `json:"ownerId"`
+	Owner   *User  `json:"owner"`
+	Text    string `json:"text"`
+}
+
 type User struct {
 	ID     int64   `json:"id"`
 	Login  string  `json:"login"`
@@ -102,6 +166,7 @@ type UserWithAdmin struct {
 	Login  string  `json:"login"`
 	Avatar *string `json:"avatar"`
 	Admin  bool    `json:"admin"`
+	Role   string  `json:"role"`
 }
 
 type VideoCallInvitationDto struct {
@@ -141,15 +141,23 @@ func convertToChatEvent(e *dto.ChatEvent) *model.ChatEvent {
 	notificationDto := e.MessageNotification
 	if notificationDto != nil {
 		result.MessageEvent = &model.DisplayMessageDto{ // dto.DisplayMessageDto
-			ID:             notificationDto.Id,
-			Text:           notificationDto.Text,
-			ChatID:         notificationDto.ChatId,
-			OwnerID:        notificationDto.OwnerId,
-			CreateDateTime: notificationDto.CreateDateTime,
-			EditDateTime:   notificationDto.EditDateTime.Ptr(),
-			Owner:          convertUser(notificationDto.Owner),
-			CanEdit:        notificationDto.CanEdit,
-			FileItemUUID:   notificationDto.FileItemUuid,
+			ID:               notificationDto.Id,
+			Text:             notificationDto.Text,
+			ChatID:           notificationDto.ChatId,
+			OwnerID:          notificationDto.OwnerId,
+			CreateDateTime:   notificationDto.CreateDateTime,
+			EditDateTime:     notificationDto.EditDateTime.Ptr(),
+			Owner:            convertUser(notificationDto.Owner),
+			CanEdit:          notificationDto.CanEdit,
+			FileItemUUID:     notificationDto.FileItemUuid,
+			ReplyToMessageID: notificationDto.ReplyToMessageId.Ptr(),
+			ReplyTo:          convertReplyTo(notificationDto.ReplyTo),
+			Reactions:        convertReactions(notificationDto.Reactions),
+			Pinned:           notificationDto.Pinned,
+			ReadBy:           notificationDto.ReadBy,
+			ForwardedFrom:    convertForwardedFrom(notificationDto.ForwardedFrom),
+			Bot:              notificationDto.Bot,
+			LinkPreviews:     convertLinkPreviews(notificationDto.LinkPreviews),
 		}
 	}
 
@@ -176,6 +184,21 @@ func convertToChatEvent(e *dto.ChatEvent) *model.ChatEvent {
 			Text:   messageBroadcast.Text,
 		}
 	}
+	reactionChanged := e.ReactionChangedNotification
+	if reactionChanged != nil {
+		result.ReactionChangedEvent = &model.ReactionChangedDto{
+			MessageID: reactionChanged.MessageId,
+			Reactions: convertReactions(reactionChanged.Reactions),
+		}
+	}
+	messageRead := e.MessageReadNotification
+	if messageRead != nil {
+		result.MessageReadEvent = &model.MessageReadDto{
+			ChatID:        messageRead.ChatId,
+			LastMessageID: messageRead.LastMessageId,
+			UserID:        messageRead.UserId,
+		}
+	}
 	return result
 }
 func convertToGlobalEvent(e *dto.GlobalEvent) *model.GlobalEvent {
@@ -196,6 +219,7 @@ func convertToGlobalEvent(e *dto.GlobalEvent) *model.GlobalEvent {
 			CanDelete:                chatDtoWithAdmin.CanDelete.Ptr(),
 			CanLeave:                 chatDtoWithAdmin.CanLeave.Ptr(),
 			UnreadMessages:           chatDtoWithAdmin.UnreadMessages,
+			UnreadMentions:           chatDtoWithAdmin.UnreadMentions,
 			CanBroadcast:             chatDtoWithAdmin.CanBroadcast,
 			CanVideoKick:             chatDtoWithAdmin.CanVideoKick,
 			CanAudioMute:             chatDtoWithAdmin.CanAudioMute,
@@ -203,6 +227,11 @@ func convertToGlobalEvent(e *dto.GlobalEvent) *model.GlobalEvent {
 			TetATet:                  chatDtoWithAdmin.IsTetATet,
 			ParticipantsCount:        chatDtoWithAdmin.ParticipantsCount,
 			ChangingParticipantsPage: chatDtoWithAdmin.ChangingParticipantsPage,
+			Pinned:                   chatDtoWithAdmin.Pinned,
+			Visibility:               chatDtoWithAdmin.Visibility,
+			Role:                     chatDtoWithAdmin.Role,
+			Channel:                  chatDtoWithAdmin.IsChannel,
+			CanWriteMessage:          chatDtoWithAdmin.CanWriteMessage,
 			Participants:             convertUsers(chatDtoWithAdmin.Participants),
 		}
 	}
@@ -270,6 +299,18 @@ func convertToGlobalEvent(e *dto.GlobalEvent) *model.GlobalEvent {
 		}
 	}
 
+	mention := e.MentionNotification
+	if mention != nil {
+		ret.MentionNotification = &model.MentionDto{
+			ChatID:         mention.ChatId,
+			MessageID:      mention.MessageId,
+			OwnerID:        mention.OwnerId,
+			Owner:          convertUser(mention.Owner),
+			Text:           mention.Text,
+			CreateDateTime: mention.CreateDateTime,
+		}
+	}
+
 	return ret
 }
 func convertUser(owner *dto.User) *model.User {
@@ -282,6 +323,51 @@ func convertUser(owner *dto.User) *model.User {
 		Avatar: owner.Avatar.Ptr(),
 	}
 }
+func convertReplyTo(replyTo *dto.ReplyToMessageDto) *model.ReplyToMessageDto {
+	if replyTo == nil {
+		return nil
+	}
+	return &model.ReplyToMessageDto{
+		ID:      replyTo.Id,
+		OwnerID: replyTo.OwnerId,
+		Owner:   convertUser(replyTo.Owner),
+		Text:    replyTo.Text,
+	}
+}
+func convertForwardedFrom(forwardedFrom *dto.ForwardedFromDto) *model.ForwardedFromDto {
+	if forwardedFrom == nil {
+		return nil
+	}
+	return &model.ForwardedFromDto{
+		ChatID:    forwardedFrom.ChatId,
+		MessageID: forwardedFrom.MessageId,
+		OwnerID:   forwardedFrom.OwnerId,
+		Owner:     convertUser(forwardedFrom.Owner),
+	}
+}
+func convertLinkPreviews(linkPreviews []*dto.LinkPreviewDto) []*model.LinkPreviewDto {
+	ret := make([]*model.LinkPreviewDto, 0)
+	for _, linkPreview := range linkPreviews {
+		ret = append(ret, &model.LinkPreviewDto{
+			URL:         linkPreview.Url,
+			Title:       linkPreview.Title.Ptr(),
+			Description: linkPreview.Description.Ptr(),
+			ImageURL:    linkPreview.ImageUrl.Ptr(),
+			SiteName:    linkPreview.SiteName.Ptr(),
+		})
+	}
+	return ret
+}
+func convertReactions(reactions []*dto.ReactionDto) []*model.ReactionDto {
+	ret := make([]*model.ReactionDto, 0)
+	for _, reaction := range reactions {
+		ret = append(ret, &model.ReactionDto{
+			Reaction: reaction.Reaction,
+			Count:    reaction.Count,
+		})
+	}
+	return ret
+}
 func convertUserWithAdmin(owner *dto.UserWithAdmin) *model.UserWithAdmin {
 	if owner == nil {
 		return nil
@@ -291,6 +377,7 @@ func convertUserWithAdmin(owner *dto.UserWithAdmin) *model.UserWithAdmin {
 		Login:  owner.Login,
 		Avatar: owner.Avatar.Ptr(),
 		Admin:  owner.Admin,
+		Role:   owner.Role,
 	}
 }
 func convertUsers(participants []*dto.UserWithAdmin) []*model.UserWithAdmin {
@@ -92,8 +92,9 @@ func (h *RestClient) CheckAccess(userId int64, chatId int64, c context.Context)
 	}
 }
 
-func (h *RestClient) IsAdmin(userId int64, chatId int64, c context.Context) (bool, error) {
-	url := fmt.Sprintf("%v%v?userId=%v&chatId=%v", h.chatBaseUrl, h.isAdminPath, userId, chatId)
+// HasPermission checks the permission of participant's role, e.g. "videoKick"
+func (h *RestClient) HasPermission(userId int64, chatId int64, permission string, c context.Context) (bool, error) {
+	url := fmt.Sprintf("%v%v?userId=%v&chatId=%v&permission=%v", h.chatBaseUrl, h.isAdminPath, userId, chatId, permission)
 
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
@@ -101,7 +102,7 @@ func (h *RestClient) IsAdmin(userId int64, chatId int64, c context.Context) (boo
 		return false, err
 	}
 
-	ctx, span := h.tracer.Start(c, "chat.IsAdmin")
+	ctx, span := h.tracer.Start(c, "chat.HasPermission")
 	defer span.End()
 	req = req.WithContext(ctx)
 
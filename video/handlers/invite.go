@@ -101,6 +101,52 @@ func (vh *InviteHandler) ProcessCallInvitation(c echo.Context) error {
 	return c.NoContent(http.StatusOK)
 }
 
+// ProcessInternalCallInvitation starts the call on behalf of the user and dials every other participant, chat uses it for the /call command
+func (vh *InviteHandler) ProcessInternalCallInvitation(c echo.Context) error {
+	chatId, err := GetPathParamAsInt64(c, "id")
+	if err != nil {
+		return err
+	}
+
+	behalfUserId, err0 := utils.ParseInt64(c.QueryParam("behalfUserId"))
+	if err0 != nil {
+		return err0
+	}
+
+	if ok, err := vh.chatClient.CheckAccess(behalfUserId, chatId, c.Request().Context()); err != nil {
+		return c.NoContent(http.StatusInternalServerError)
+	} else if !ok {
+		return c.NoContent(http.StatusUnauthorized)
+	}
+
+	dialingUserId, err := vh.dialRedisRepository.GetDialMetadata(c.Request().Context(), chatId)
+	if err != nil {
+		logger.GetLogEntry(c.Request().Context()).Errorf("Error %v", err)
+		return c.NoContent(http.StatusInternalServerError)
+	}
+	if dialingUserId != services.NoUser && dialingUserId != behalfUserId {
+		logger.GetLogEntry(c.Request().Context()).Infof("Call already started in this chat %v by %v", chatId, dialingUserId)
+		return c.NoContent(http.StatusAccepted)
+	}
+
+	participantIds, err := vh.chatClient.GetChatParticipantIds(chatId, c.Request().Context())
+	if err != nil {
+		return c.NoContent(http.StatusInternalServerError)
+	}
+	for _, userId := range participantIds {
+		// the bots of chat have the negative ids, they don't answer the calls
+		if userId == behalfUserId || userId < 0 {
+			continue
+		}
+		if err := vh.dialRedisRepository.AddToDialList(c.Request().Context(), userId, chatId, behalfUserId); err != nil {
+			logger.GetLogEntry(c.Request().Context()).Errorf("Error %v", err)
+			return c.NoContent(http.StatusInternalServerError)
+		}
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
 func (vh *InviteHandler) ProcessCancelInvitation(c echo.Context) error {
 	var userPrincipalDto, ok = c.Get(utils.USER_PRINCIPAL_DTO).(*auth.AuthResult)
 	if !ok {
@@ -37,7 +37,7 @@ func (rh *RecordHandler) StartRecording(c echo.Context) error {
 	if err != nil {
 		return err
 	}
-	if ok, err := rh.restClient.IsAdmin(userPrincipalDto.UserId, chatId, c.Request().Context()); err != nil {
+	if ok, err := rh.restClient.HasPermission(userPrincipalDto.UserId, chatId, "videoRecord", c.Request().Context()); err != nil {
 		return c.NoContent(http.StatusInternalServerError)
 	} else {
 		if !ok {
@@ -106,7 +106,7 @@ func (rh *RecordHandler) StopRecording(c echo.Context) error {
 	if err != nil {
 		return err
 	}
-	if ok, err := rh.restClient.IsAdmin(userPrincipalDto.UserId, chatId, c.Request().Context()); err != nil {
+	if ok, err := rh.restClient.HasPermission(userPrincipalDto.UserId, chatId, "videoRecord", c.Request().Context()); err != nil {
 		return c.NoContent(http.StatusInternalServerError)
 	} else {
 		if !ok {
@@ -144,7 +144,7 @@ func (rh *RecordHandler) StatusRecording(c echo.Context) error {
 	if err != nil {
 		return err
 	}
-	if ok, err := rh.restClient.IsAdmin(userPrincipalDto.UserId, chatId, c.Request().Context()); err != nil {
+	if ok, err := rh.restClient.HasPermission(userPrincipalDto.UserId, chatId, "videoRecord", c.Request().Context()); err != nil {
 		return c.NoContent(http.StatusInternalServerError)
 	} else {
 		if !ok {
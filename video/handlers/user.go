@@ -65,7 +65,7 @@ func (h *UserHandler) Kick(c echo.Context) error {
 	if err != nil {
 		return err
 	}
-	if ok, err := h.chatClient.IsAdmin(userPrincipalDto.UserId, chatId, c.Request().Context()); err != nil {
+	if ok, err := h.chatClient.HasPermission(userPrincipalDto.UserId, chatId, "videoKick", c.Request().Context()); err != nil {
 		return c.NoContent(http.StatusInternalServerError)
 	} else if !ok {
 		return c.NoContent(http.StatusUnauthorized)
@@ -118,7 +118,7 @@ func (h *UserHandler) Mute(c echo.Context) error {
 	if err != nil {
 		return err
 	}
-	if ok, err := h.chatClient.IsAdmin(userPrincipalDto.UserId, chatId, c.Request().Context()); err != nil {
+	if ok, err := h.chatClient.HasPermission(userPrincipalDto.UserId, chatId, "audioMute", c.Request().Context()); err != nil {
 		return c.NoContent(http.StatusInternalServerError)
 	} else if !ok {
 		return c.NoContent(http.StatusUnauthorized)
@@ -167,6 +167,7 @@ func configureApiEcho(
 	e.PUT("/video/:id/dial", ih.ProcessCallInvitation)
 	e.PUT("/video/:id/dial/cancel", ih.ProcessCancelInvitation)
 	e.PUT("/video/:id/dial/stop", ih.ProcessAsOwnerLeave)
+	e.PUT("/internal/video/:id/dial", ih.ProcessInternalCallInvitation)
 	e.PUT("/video/:id/record/start", rh.StartRecording)
 	e.PUT("/video/:id/record/stop", rh.StopRecording)
 	e.GET("/video/:id/record/status", rh.StatusRecording)